@@ -0,0 +1,110 @@
+package bloom
+
+import (
+	"hash/maphash"
+	"math"
+	"sync"
+
+	"github.com/chrisarmitage/go-data-structures/set"
+)
+
+// BloomFilter represents a thread-safe probabilistic membership test.
+// MightContain never returns false for data that was added, but may return true for data
+// that was not, at roughly the false-positive rate the filter was sized for.
+// The zero value is not usable; use NewBloomFilter to create a new BloomFilter.
+type BloomFilter struct {
+	bits   []uint64
+	size   uint64
+	hashes uint64
+	seed1  maphash.Seed
+	seed2  maphash.Seed
+	mu     sync.RWMutex
+}
+
+// NewBloomFilter creates an empty BloomFilter sized to hold expectedItems elements while keeping
+// the false-positive rate close to falsePositiveRate (e.g. 0.01 for 1%).
+// Adding more items than expected increases the false-positive rate.
+//
+// Example:
+//
+//	b := NewBloomFilter(1000, 0.01)
+//	b.Add([]byte("foo"))
+func NewBloomFilter(expectedItems int, falsePositiveRate float64) *BloomFilter {
+	n := math.Max(float64(expectedItems), 1)
+	p := math.Min(math.Max(falsePositiveRate, math.SmallestNonzeroFloat64), 1)
+
+	size := uint64(math.Ceil(-n * math.Log(p) / (math.Ln2 * math.Ln2)))
+	size = max(size, 64)
+	hashes := uint64(math.Round(float64(size) / n * math.Ln2))
+	hashes = max(hashes, 1)
+
+	return &BloomFilter{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+		seed1:  maphash.MakeSeed(),
+		seed2:  maphash.MakeSeed(),
+	}
+}
+
+// NewBloomFilterFromSet creates a BloomFilter sized for the members of s and adds all of them.
+//
+// Example:
+//
+//	s := set.NewSet[string]()
+//	s.Add("foo")
+//	b := NewBloomFilterFromSet(s, 0.01)
+//	fmt.Println(b.MightContain([]byte("foo"))) // Output: true
+func NewBloomFilterFromSet(s *set.Set[string], falsePositiveRate float64) *BloomFilter {
+	members := s.Members()
+	b := NewBloomFilter(len(members), falsePositiveRate)
+	for _, member := range members {
+		b.Add([]byte(member))
+	}
+	return b
+}
+
+// Add records data in the BloomFilter.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	b := NewBloomFilter(1000, 0.01)
+//	b.Add([]byte("foo"))
+func (b *BloomFilter) Add(data []byte) {
+	h1, h2 := b.hash(data)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i := uint64(0); i < b.hashes; i++ {
+		bit := (h1 + i*h2) % b.size
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// MightContain returns false if data was definitely never added, or true if it probably was.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	b := NewBloomFilter(1000, 0.01)
+//	b.Add([]byte("foo"))
+//	fmt.Println(b.MightContain([]byte("foo"))) // Output: true
+//	fmt.Println(b.MightContain([]byte("bar"))) // Output: false (almost certainly)
+func (b *BloomFilter) MightContain(data []byte) bool {
+	h1, h2 := b.hash(data)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for i := uint64(0); i < b.hashes; i++ {
+		bit := (h1 + i*h2) % b.size
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// hash returns the two base hashes combined via double hashing to derive each bit position.
+func (b *BloomFilter) hash(data []byte) (uint64, uint64) {
+	// h2 is forced odd so successive positions don't collapse onto the same bit
+	return maphash.Bytes(b.seed1, data), maphash.Bytes(b.seed2, data) | 1
+}
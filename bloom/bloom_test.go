@@ -0,0 +1,63 @@
+package bloom
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/chrisarmitage/go-data-structures/set"
+)
+
+func TestBloomFilter_NoFalseNegatives(t *testing.T) {
+	filter := NewBloomFilter(1000, 0.01)
+	assertEquals(t, filter.MightContain([]byte("item-0")), false)
+
+	for i := 0; i < 1000; i++ {
+		filter.Add([]byte("item-" + strconv.Itoa(i)))
+	}
+
+	for i := 0; i < 1000; i++ {
+		if !filter.MightContain([]byte("item-" + strconv.Itoa(i))) {
+			t.Fatalf("false negative for item-%d", i)
+		}
+	}
+}
+
+func TestBloomFilter_FalsePositiveRate(t *testing.T) {
+	filter := NewBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		filter.Add([]byte("item-" + strconv.Itoa(i)))
+	}
+
+	falsePositives := 0
+	samples := 10000
+	for i := 0; i < samples; i++ {
+		if filter.MightContain([]byte("other-" + strconv.Itoa(i))) {
+			falsePositives++
+		}
+	}
+
+	rate := float64(falsePositives) / float64(samples)
+	if rate > 0.03 {
+		t.Errorf("false-positive rate %.4f exceeds tolerance", rate)
+	}
+}
+
+func TestBloomFilter_FromSet(t *testing.T) {
+	s := set.NewSet[string]()
+	s.Add("foo")
+	s.Add("bar")
+	s.Add("baz")
+
+	filter := NewBloomFilterFromSet(s, 0.01)
+
+	assertEquals(t, filter.MightContain([]byte("foo")), true)
+	assertEquals(t, filter.MightContain([]byte("bar")), true)
+	assertEquals(t, filter.MightContain([]byte("baz")), true)
+}
+
+func assertEquals[V comparable](t *testing.T, got, want V) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,236 @@
+package bst
+
+import "iter"
+
+// BST represents a generic binary search tree of unique values, ordered by a comparator.
+// It is not self-balancing: operations run in O(h), where h is the height of the tree,
+// which degrades to O(n) when values are inserted in sorted order.
+// The zero value is not usable; use NewBST to create a new BST.
+type BST[T any] struct {
+	root    *node[T]
+	size    int
+	compare func(a, b T) int
+}
+
+type node[T any] struct {
+	value       T
+	left, right *node[T]
+}
+
+// NewBST creates and returns an empty tree ordered by compare, which must return a negative
+// number when a < b, zero when a == b, and a positive number when a > b (e.g. cmp.Compare).
+//
+// Example:
+//
+//	tree := NewBST[int](cmp.Compare[int])
+//	tree.Insert(5)
+func NewBST[T any](compare func(a, b T) int) *BST[T] {
+	return &BST[T]{
+		compare: compare,
+	}
+}
+
+// Insert adds a value to the tree.
+// Returns true if the value was added, or false if an equal value was already present.
+//
+// Example:
+//
+//	tree := NewBST[int](cmp.Compare[int])
+//	fmt.Println(tree.Insert(5)) // Output: true
+//	fmt.Println(tree.Insert(5)) // Output: false
+func (t *BST[T]) Insert(value T) bool {
+	link := &t.root
+	for *link != nil {
+		c := t.compare(value, (*link).value)
+		switch {
+		case c < 0:
+			link = &(*link).left
+		case c > 0:
+			link = &(*link).right
+		default:
+			return false
+		}
+	}
+
+	*link = &node[T]{value: value}
+	t.size++
+
+	return true
+}
+
+// Delete removes a value from the tree.
+// Returns true if the value was present and removed, false otherwise.
+//
+// Example:
+//
+//	tree := NewBST[int](cmp.Compare[int])
+//	tree.Insert(5)
+//	fmt.Println(tree.Delete(5)) // Output: true
+//	fmt.Println(tree.Delete(5)) // Output: false
+func (t *BST[T]) Delete(value T) bool {
+	link := &t.root
+	for *link != nil {
+		c := t.compare(value, (*link).value)
+		if c == 0 {
+			break
+		}
+		if c < 0 {
+			link = &(*link).left
+		} else {
+			link = &(*link).right
+		}
+	}
+
+	target := *link
+	if target == nil {
+		return false
+	}
+
+	switch {
+	case target.left == nil:
+		*link = target.right
+	case target.right == nil:
+		*link = target.left
+	default:
+		// Two children: replace the value with its in-order successor, the smallest value
+		// in the right subtree, then unlink the successor node, which has no left child.
+		successorLink := &target.right
+		for (*successorLink).left != nil {
+			successorLink = &(*successorLink).left
+		}
+		successor := *successorLink
+		target.value = successor.value
+		*successorLink = successor.right
+	}
+
+	t.size--
+
+	return true
+}
+
+// Contains returns true if an equal value is in the tree, false otherwise.
+//
+// Example:
+//
+//	tree := NewBST[int](cmp.Compare[int])
+//	tree.Insert(5)
+//	fmt.Println(tree.Contains(5)) // Output: true
+func (t *BST[T]) Contains(value T) bool {
+	n := t.root
+	for n != nil {
+		c := t.compare(value, n.value)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+// Len returns the number of values in the tree.
+//
+// Example:
+//
+//	tree := NewBST[int](cmp.Compare[int])
+//	tree.Insert(5)
+//	fmt.Println(tree.Len()) // Output: 1
+func (t *BST[T]) Len() int {
+	return t.size
+}
+
+// Floor returns the largest value in the tree that is less than or equal to value.
+// Returns the value and true if successful, or zero value and false if there is none.
+//
+// Example:
+//
+//	tree := NewBST[int](cmp.Compare[int])
+//	tree.Insert(10)
+//	tree.Insert(20)
+//	val, ok := tree.Floor(15) // val = 10, ok = true
+//	val, ok = tree.Floor(5)   // val = 0, ok = false
+func (t *BST[T]) Floor(value T) (T, bool) {
+	var result T
+	found := false
+	n := t.root
+	for n != nil {
+		c := t.compare(value, n.value)
+		switch {
+		case c == 0:
+			return n.value, true
+		case c < 0:
+			n = n.left
+		default:
+			// n is a candidate; a closer one can only be to its right
+			result, found = n.value, true
+			n = n.right
+		}
+	}
+
+	return result, found
+}
+
+// Ceiling returns the smallest value in the tree that is greater than or equal to value.
+// Returns the value and true if successful, or zero value and false if there is none.
+//
+// Example:
+//
+//	tree := NewBST[int](cmp.Compare[int])
+//	tree.Insert(10)
+//	tree.Insert(20)
+//	val, ok := tree.Ceiling(15) // val = 20, ok = true
+//	val, ok = tree.Ceiling(25)  // val = 0, ok = false
+func (t *BST[T]) Ceiling(value T) (T, bool) {
+	var result T
+	found := false
+	n := t.root
+	for n != nil {
+		c := t.compare(value, n.value)
+		switch {
+		case c == 0:
+			return n.value, true
+		case c > 0:
+			n = n.right
+		default:
+			// n is a candidate; a closer one can only be to its left
+			result, found = n.value, true
+			n = n.left
+		}
+	}
+
+	return result, found
+}
+
+// All returns an iterator over the values in the tree in ascending order.
+// The tree must not be modified during iteration.
+//
+// Example:
+//
+//	tree := NewBST[int](cmp.Compare[int])
+//	tree.Insert(2)
+//	tree.Insert(1)
+//	for v := range tree.All() {
+//		fmt.Println(v) // Output: 1, 2
+//	}
+func (t *BST[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		stack := make([]*node[T], 0)
+		n := t.root
+		for n != nil || len(stack) > 0 {
+			for n != nil {
+				stack = append(stack, n)
+				n = n.left
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.value) {
+				return
+			}
+			n = n.right
+		}
+	}
+}
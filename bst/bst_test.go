@@ -0,0 +1,139 @@
+package bst
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func newTestTree(values ...int) *BST[int] {
+	tree := NewBST[int](cmp.Compare[int])
+	for _, v := range values {
+		tree.Insert(v)
+	}
+	return tree
+}
+
+func TestBST_InsertContains(t *testing.T) {
+	tree := newTestTree()
+	assertEquals(t, tree.Len(), 0)
+	assertEquals(t, tree.Contains(1), false)
+
+	assertEquals(t, tree.Insert(50), true)
+	assertEquals(t, tree.Insert(30), true)
+	assertEquals(t, tree.Insert(70), true)
+	assertEquals(t, tree.Insert(30), false)
+	assertEquals(t, tree.Len(), 3)
+
+	assertEquals(t, tree.Contains(30), true)
+	assertEquals(t, tree.Contains(70), true)
+	assertEquals(t, tree.Contains(40), false)
+}
+
+func TestBST_All(t *testing.T) {
+	tree := newTestTree(50, 30, 70, 20, 40, 60, 80)
+	assertEquals(t, slices.Equal(slices.Collect(tree.All()), []int{20, 30, 40, 50, 60, 70, 80}), true)
+
+	visited := make([]int, 0)
+	for v := range tree.All() {
+		visited = append(visited, v)
+		if len(visited) == 3 {
+			break
+		}
+	}
+	assertEquals(t, slices.Equal(visited, []int{20, 30, 40}), true)
+
+	assertEquals(t, len(slices.Collect(newTestTree().All())), 0)
+}
+
+func TestBST_FloorCeiling(t *testing.T) {
+	tree := newTestTree()
+	_, ok := tree.Floor(1)
+	assertEquals(t, ok, false)
+	_, ok = tree.Ceiling(1)
+	assertEquals(t, ok, false)
+
+	tree = newTestTree(50, 30, 70, 20, 40, 60, 80)
+
+	v, ok := tree.Floor(45)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 40)
+	v, ok = tree.Ceiling(45)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 50)
+
+	// Exact matches
+	v, _ = tree.Floor(60)
+	assertEquals(t, v, 60)
+	v, _ = tree.Ceiling(60)
+	assertEquals(t, v, 60)
+
+	// Boundaries
+	v, ok = tree.Floor(20)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 20)
+	_, ok = tree.Floor(19)
+	assertEquals(t, ok, false)
+	v, ok = tree.Ceiling(80)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 80)
+	_, ok = tree.Ceiling(81)
+	assertEquals(t, ok, false)
+	v, _ = tree.Floor(1000)
+	assertEquals(t, v, 80)
+	v, _ = tree.Ceiling(-1000)
+	assertEquals(t, v, 20)
+}
+
+func TestBST_Delete(t *testing.T) {
+	tree := newTestTree(50, 30, 70, 20, 40, 60, 80, 65)
+
+	assertEquals(t, tree.Delete(99), false)
+
+	// Leaf
+	assertEquals(t, tree.Delete(20), true)
+	assertEquals(t, slices.Equal(slices.Collect(tree.All()), []int{30, 40, 50, 60, 65, 70, 80}), true)
+
+	// One child
+	assertEquals(t, tree.Delete(60), true)
+	assertEquals(t, slices.Equal(slices.Collect(tree.All()), []int{30, 40, 50, 65, 70, 80}), true)
+
+	// Two children, replaced by its in-order successor
+	assertEquals(t, tree.Delete(70), true)
+	assertEquals(t, slices.Equal(slices.Collect(tree.All()), []int{30, 40, 50, 65, 80}), true)
+
+	// Root with two children
+	assertEquals(t, tree.Delete(50), true)
+	assertEquals(t, slices.Equal(slices.Collect(tree.All()), []int{30, 40, 65, 80}), true)
+	assertEquals(t, tree.Len(), 4)
+	assertEquals(t, tree.Contains(50), false)
+	assertEquals(t, tree.Delete(50), false)
+
+	v, _ := tree.Floor(50)
+	assertEquals(t, v, 40)
+	v, _ = tree.Ceiling(50)
+	assertEquals(t, v, 65)
+
+	for _, value := range []int{30, 40, 65, 80} {
+		assertEquals(t, tree.Delete(value), true)
+	}
+	assertEquals(t, tree.Len(), 0)
+	assertEquals(t, tree.Insert(1), true)
+}
+
+func TestBST_CustomComparator(t *testing.T) {
+	tree := NewBST[string](func(a, b string) int { return cmp.Compare(len(a), len(b)) })
+	tree.Insert("ccc")
+	tree.Insert("a")
+	tree.Insert("bb")
+	assertEquals(t, tree.Insert("zz"), false)
+
+	assertEquals(t, slices.Equal(slices.Collect(tree.All()), []string{"a", "bb", "ccc"}), true)
+}
+
+func assertEquals[V comparable](t *testing.T, got, want V) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,184 @@
+package circularlist
+
+// Node is an element of a CircularList.
+type Node[T any] struct {
+	Value T
+
+	next, prev *Node[T]
+	list       *CircularList[T]
+}
+
+// CircularList represents a generic circular doubly linked list, where moving past the
+// last element wraps around to the first. It suits round-robin scheduling.
+// The zero value is not usable; use NewCircularList to create a new CircularList.
+type CircularList[T any] struct {
+	head   *Node[T]
+	length int
+}
+
+// NewCircularList creates and returns an empty circular list that can store elements of type T.
+//
+// Example:
+//
+//	l := NewCircularList[string]()
+//	l.Insert("worker-1")
+func NewCircularList[T any]() *CircularList[T] {
+	return &CircularList[T]{}
+}
+
+// Insert adds a value at the end of the list, just before the first element, and returns its node.
+//
+// Example:
+//
+//	l := NewCircularList[int]()
+//	l.Insert(1) // list now contains: [1]
+//	l.Insert(2) // list now contains: [1, 2], wrapping back to 1
+func (l *CircularList[T]) Insert(value T) *Node[T] {
+	node := &Node[T]{Value: value, list: l}
+
+	if l.head == nil {
+		node.next = node
+		node.prev = node
+		l.head = node
+	} else {
+		tail := l.head.prev
+		node.prev = tail
+		node.next = l.head
+		tail.next = node
+		l.head.prev = node
+	}
+
+	l.length++
+
+	return node
+}
+
+// Remove unlinks node from the list.
+// Returns true if the node was removed, or false if it does not belong to this list.
+// A Cursor positioned on the removed node continues from the node that followed it.
+//
+// Example:
+//
+//	l := NewCircularList[int]()
+//	node := l.Insert(1)
+//	l.Remove(node) // list is now empty
+func (l *CircularList[T]) Remove(node *Node[T]) bool {
+	if node == nil || node.list != l {
+		return false
+	}
+
+	if l.length == 1 {
+		l.head = nil
+		// end the chain so stale cursors restart from the head once new values are inserted
+		node.next = nil
+	} else {
+		node.prev.next = node.next
+		node.next.prev = node.prev
+		if l.head == node {
+			l.head = node.next
+		}
+	}
+
+	// node.next is kept so cursors on the removed node can still advance
+	node.prev = nil
+	node.list = nil
+	l.length--
+
+	return true
+}
+
+// Len returns the number of elements in the list.
+//
+// Example:
+//
+//	l := NewCircularList[int]()
+//	l.Insert(1)
+//	fmt.Println(l.Len()) // Output: 1
+func (l *CircularList[T]) Len() int {
+	return l.length
+}
+
+// Cursor returns a new cursor positioned before the first element of the list.
+//
+// Example:
+//
+//	l := NewCircularList[int]()
+//	l.Insert(1)
+//	c := l.Cursor()
+//	val, ok := c.Next() // val = 1, ok = true
+func (l *CircularList[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{list: l}
+}
+
+// Cursor iterates a CircularList endlessly, wrapping from the last element back to the first.
+type Cursor[T any] struct {
+	list *CircularList[T]
+	node *Node[T]
+}
+
+// Next advances the cursor and returns the value it lands on.
+// The first call returns the first element; advancing past the last element wraps to the first.
+// Returns the value and true if successful, or zero value and false if the list is empty.
+//
+// Example:
+//
+//	l := NewCircularList[int]()
+//	l.Insert(1)
+//	l.Insert(2)
+//	c := l.Cursor()
+//	c.Next()           // 1
+//	c.Next()           // 2
+//	val, ok := c.Next() // val = 1, ok = true (wrapped)
+func (c *Cursor[T]) Next() (T, bool) {
+	if c.list.length == 0 {
+		var empty T
+		return empty, false
+	}
+
+	if c.node == nil {
+		c.node = c.list.head
+	} else {
+		c.node = c.node.next
+		// skip past nodes removed since the cursor last moved
+		for c.node != nil && c.node.list != c.list {
+			c.node = c.node.next
+		}
+		if c.node == nil {
+			c.node = c.list.head
+		}
+	}
+
+	return c.node.Value, true
+}
+
+// Value returns the value the cursor is positioned on.
+// Returns the value and true if successful, or zero value and false if the cursor has not
+// been advanced yet or its node has been removed.
+//
+// Example:
+//
+//	l := NewCircularList[int]()
+//	l.Insert(1)
+//	c := l.Cursor()
+//	c.Next()
+//	val, ok := c.Value() // val = 1, ok = true
+func (c *Cursor[T]) Value() (T, bool) {
+	if c.node == nil || c.node.list != c.list {
+		var empty T
+		return empty, false
+	}
+
+	return c.node.Value, true
+}
+
+// Node returns the node the cursor is positioned on, or nil if it has not been advanced yet.
+// This is useful for removing the current element.
+//
+// Example:
+//
+//	c := l.Cursor()
+//	c.Next()
+//	l.Remove(c.Node())
+func (c *Cursor[T]) Node() *Node[T] {
+	return c.node
+}
@@ -0,0 +1,112 @@
+package circularlist
+
+import (
+	"testing"
+)
+
+func TestCircularList(t *testing.T) {
+	list := NewCircularList[int]()
+	assertEquals(t, list.Len(), 0)
+
+	cursor := list.Cursor()
+	_, ok := cursor.Next()
+	assertEquals(t, ok, false)
+
+	one := list.Insert(1)
+	list.Insert(2)
+	three := list.Insert(3)
+	assertEquals(t, list.Len(), 3)
+
+	assertEquals(t, list.Remove(nil), false)
+	assertEquals(t, list.Remove(NewCircularList[int]().Insert(1)), false)
+
+	assertEquals(t, list.Remove(one), true)
+	assertEquals(t, list.Remove(one), false)
+	assertEquals(t, list.Len(), 2)
+
+	assertEquals(t, list.Remove(three), true)
+	assertEquals(t, list.Len(), 1)
+
+	cursor = list.Cursor()
+	v, _ := cursor.Next()
+	assertEquals(t, v, 2)
+	v, _ = cursor.Next()
+	assertEquals(t, v, 2)
+}
+
+func TestCircularList_CursorWraps(t *testing.T) {
+	list := NewCircularList[string]()
+	list.Insert("a")
+	list.Insert("b")
+	list.Insert("c")
+
+	cursor := list.Cursor()
+	_, ok := cursor.Value()
+	assertEquals(t, ok, false)
+
+	visited := ""
+	for i := 0; i < 7; i++ {
+		v, ok := cursor.Next()
+		assertEquals(t, ok, true)
+		visited += v
+	}
+	assertEquals(t, visited, "abcabca")
+
+	v, ok := cursor.Value()
+	assertEquals(t, ok, true)
+	assertEquals(t, v, "a")
+}
+
+func TestCircularList_RemoveUnderCursor(t *testing.T) {
+	list := NewCircularList[string]()
+	list.Insert("a")
+	list.Insert("b")
+	list.Insert("c")
+
+	cursor := list.Cursor()
+	cursor.Next()
+	v, _ := cursor.Next()
+	assertEquals(t, v, "b")
+
+	assertEquals(t, list.Remove(cursor.Node()), true)
+	assertEquals(t, list.Len(), 2)
+	_, ok := cursor.Value()
+	assertEquals(t, ok, false)
+
+	// The cursor continues from the element after the removed one
+	v, _ = cursor.Next()
+	assertEquals(t, v, "c")
+	v, _ = cursor.Next()
+	assertEquals(t, v, "a")
+	v, _ = cursor.Next()
+	assertEquals(t, v, "c")
+
+	// Removing the head under the cursor moves the head along
+	v, _ = cursor.Next()
+	assertEquals(t, v, "a")
+	list.Remove(cursor.Node())
+	list.Remove(list.head)
+	assertEquals(t, list.Len(), 0)
+	_, ok = cursor.Next()
+	assertEquals(t, ok, false)
+
+	list.Insert("d")
+	list.Insert("e")
+	v, ok = list.Cursor().Next()
+	assertEquals(t, ok, true)
+	assertEquals(t, v, "d")
+
+	// A cursor left on a removed node restarts from the new head
+	v, ok = cursor.Next()
+	assertEquals(t, ok, true)
+	assertEquals(t, v, "d")
+	v, _ = cursor.Next()
+	assertEquals(t, v, "e")
+}
+
+func assertEquals[V comparable](t *testing.T, got, want V) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
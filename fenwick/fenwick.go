@@ -0,0 +1,95 @@
+package fenwick
+
+import "fmt"
+
+// BIT is a Fenwick tree (binary indexed tree) over int64 values, supporting point updates and
+// prefix sums in O(log n). Indices are zero-based and every value starts at zero.
+// The zero value is not usable; use NewBIT to create a new BIT.
+type BIT struct {
+	// tree is one-based internally; tree[0] is unused
+	tree []int64
+}
+
+// NewBIT creates a BIT holding size values, all initially zero.
+//
+// Example:
+//
+//	b := NewBIT(10)
+//	b.Add(3, 5)
+func NewBIT(size int) *BIT {
+	return &BIT{
+		tree: make([]int64, max(size, 0)+1),
+	}
+}
+
+// Len returns the number of values in the BIT.
+//
+// Example:
+//
+//	b := NewBIT(10)
+//	fmt.Println(b.Len()) // Output: 10
+func (b *BIT) Len() int {
+	return len(b.tree) - 1
+}
+
+// Add adds delta to the value at index i.
+// Panics if i is out of bounds.
+//
+// Example:
+//
+//	b := NewBIT(10)
+//	b.Add(3, 5)
+//	b.Add(3, -2)
+//	fmt.Println(b.RangeSum(3, 3)) // Output: 3
+func (b *BIT) Add(i int, delta int64) {
+	b.checkIndex(i)
+	for j := i + 1; j < len(b.tree); j += j & -j {
+		b.tree[j] += delta
+	}
+}
+
+// PrefixSum returns the sum of the values at indices 0 through i, inclusive.
+// Panics if i is out of bounds.
+//
+// Example:
+//
+//	b := NewBIT(10)
+//	b.Add(0, 1)
+//	b.Add(2, 4)
+//	fmt.Println(b.PrefixSum(2)) // Output: 5
+func (b *BIT) PrefixSum(i int) int64 {
+	b.checkIndex(i)
+	var sum int64
+	for j := i + 1; j > 0; j -= j & -j {
+		sum += b.tree[j]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of the values at indices lo through hi, inclusive.
+// Returns 0 if lo is greater than hi. Panics if either index is out of bounds.
+//
+// Example:
+//
+//	b := NewBIT(10)
+//	b.Add(1, 1)
+//	b.Add(2, 4)
+//	b.Add(5, 9)
+//	fmt.Println(b.RangeSum(2, 5)) // Output: 13
+func (b *BIT) RangeSum(lo, hi int) int64 {
+	b.checkIndex(lo)
+	b.checkIndex(hi)
+	if lo > hi {
+		return 0
+	}
+	if lo == 0 {
+		return b.PrefixSum(hi)
+	}
+	return b.PrefixSum(hi) - b.PrefixSum(lo-1)
+}
+
+func (b *BIT) checkIndex(i int) {
+	if i < 0 || i >= b.Len() {
+		panic(fmt.Sprintf("fenwick: index %d out of bounds for length %d", i, b.Len()))
+	}
+}
@@ -0,0 +1,75 @@
+package fenwick
+
+import (
+	"testing"
+)
+
+func TestBIT_PrefixSum(t *testing.T) {
+	bit := NewBIT(8)
+	assertEquals(t, bit.Len(), 8)
+	assertEquals(t, bit.PrefixSum(7), int64(0))
+
+	bit.Add(0, 3)
+	bit.Add(3, 5)
+	bit.Add(7, 2)
+	bit.Add(3, -1)
+
+	assertEquals(t, bit.PrefixSum(0), int64(3))
+	assertEquals(t, bit.PrefixSum(2), int64(3))
+	assertEquals(t, bit.PrefixSum(3), int64(7))
+	assertEquals(t, bit.PrefixSum(6), int64(7))
+	assertEquals(t, bit.PrefixSum(7), int64(9))
+}
+
+func TestBIT_RangeSum(t *testing.T) {
+	const size = 20
+	bit := NewBIT(size)
+	values := make([]int64, size)
+
+	updates := []struct {
+		i     int
+		delta int64
+	}{{0, 4}, {19, 7}, {5, 3}, {12, -6}, {5, 10}, {9, 1}, {0, -2}, {13, 8}}
+	for _, update := range updates {
+		bit.Add(update.i, update.delta)
+		values[update.i] += update.delta
+	}
+
+	for lo := 0; lo < size; lo++ {
+		for hi := lo; hi < size; hi++ {
+			var want int64
+			for i := lo; i <= hi; i++ {
+				want += values[i]
+			}
+			assertEquals(t, bit.RangeSum(lo, hi), want)
+		}
+	}
+
+	assertEquals(t, bit.RangeSum(5, 4), int64(0))
+}
+
+func TestBIT_OutOfBounds(t *testing.T) {
+	bit := NewBIT(3)
+
+	assertPanics(t, func() { bit.Add(3, 1) })
+	assertPanics(t, func() { bit.Add(-1, 1) })
+	assertPanics(t, func() { bit.PrefixSum(3) })
+	assertPanics(t, func() { bit.RangeSum(0, 3) })
+}
+
+func assertPanics(t *testing.T, fn func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("failed to panic")
+		}
+	}()
+	fn()
+}
+
+func assertEquals[V comparable](t *testing.T, got, want V) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,121 @@
+package grid
+
+import "fmt"
+
+// Point identifies a cell in a Grid by its column X and row Y, both zero-based.
+type Point struct {
+	X, Y int
+}
+
+// Grid is a fixed-size two-dimensional grid of values, stored row by row.
+// Every cell starts as the zero value of T.
+// The zero value is not usable; use NewGrid to create a new Grid.
+type Grid[T any] struct {
+	width, height int
+	cells         []T
+}
+
+// NewGrid creates a Grid with the given width and height. Negative dimensions are treated as zero.
+//
+// Example:
+//
+//	g := NewGrid[int](3, 2)
+//	g.Set(2, 1, 7)
+func NewGrid[T any](width, height int) *Grid[T] {
+	width, height = max(width, 0), max(height, 0)
+	return &Grid[T]{
+		width:  width,
+		height: height,
+		cells:  make([]T, width*height),
+	}
+}
+
+// Width returns the number of columns in the Grid.
+//
+// Example:
+//
+//	g := NewGrid[int](3, 2)
+//	fmt.Println(g.Width()) // Output: 3
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows in the Grid.
+//
+// Example:
+//
+//	g := NewGrid[int](3, 2)
+//	fmt.Println(g.Height()) // Output: 2
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+// InBounds returns true if (x, y) is a cell of the Grid, false otherwise.
+//
+// Example:
+//
+//	g := NewGrid[int](3, 2)
+//	fmt.Println(g.InBounds(2, 1)) // Output: true
+//	fmt.Println(g.InBounds(3, 0)) // Output: false
+func (g *Grid[T]) InBounds(x, y int) bool {
+	return x >= 0 && x < g.width && y >= 0 && y < g.height
+}
+
+// Get returns the value at (x, y).
+// Panics if (x, y) is out of bounds.
+//
+// Example:
+//
+//	g := NewGrid[string](2, 2)
+//	g.Set(1, 0, "a")
+//	fmt.Println(g.Get(1, 0)) // Output: a
+func (g *Grid[T]) Get(x, y int) T {
+	return g.cells[g.index(x, y)]
+}
+
+// Set stores v at (x, y).
+// Panics if (x, y) is out of bounds.
+//
+// Example:
+//
+//	g := NewGrid[bool](2, 2)
+//	g.Set(0, 1, true)
+func (g *Grid[T]) Set(x, y int, v T) {
+	g.cells[g.index(x, y)] = v
+}
+
+// Neighbors returns the in-bounds cells adjacent to (x, y), in row-major order.
+// Without diagonal only the four orthogonal neighbours are considered; with diagonal all eight are.
+// Cells outside the Grid are omitted, so corner and edge cells have fewer neighbours.
+// Panics if (x, y) is out of bounds.
+//
+// Example:
+//
+//	g := NewGrid[int](3, 3)
+//	fmt.Println(g.Neighbors(0, 0, false)) // Output: [{1 0} {0 1}]
+//	fmt.Println(g.Neighbors(0, 0, true))  // Output: [{1 0} {0 1} {1 1}]
+func (g *Grid[T]) Neighbors(x, y int, diagonal bool) []Point {
+	g.index(x, y)
+	neighbors := make([]Point, 0, 8)
+	for dy := -1; dy <= 1; dy++ {
+		for dx := -1; dx <= 1; dx++ {
+			if dx == 0 && dy == 0 {
+				continue
+			}
+			if !diagonal && dx != 0 && dy != 0 {
+				continue
+			}
+			if g.InBounds(x+dx, y+dy) {
+				neighbors = append(neighbors, Point{X: x + dx, Y: y + dy})
+			}
+		}
+	}
+	return neighbors
+}
+
+func (g *Grid[T]) index(x, y int) int {
+	if !g.InBounds(x, y) {
+		panic(fmt.Sprintf("grid: point (%d, %d) out of bounds for %dx%d grid", x, y, g.width, g.height))
+	}
+	return y*g.width + x
+}
@@ -0,0 +1,76 @@
+package grid
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGrid_GetSet(t *testing.T) {
+	grid := NewGrid[int](3, 2)
+	assertEquals(t, grid.Width(), 3)
+	assertEquals(t, grid.Height(), 2)
+	assertEquals(t, grid.Get(2, 1), 0)
+
+	grid.Set(2, 1, 7)
+	grid.Set(0, 0, 1)
+	assertEquals(t, grid.Get(2, 1), 7)
+	assertEquals(t, grid.Get(0, 0), 1)
+	assertEquals(t, grid.Get(1, 0), 0)
+}
+
+func TestGrid_InBounds(t *testing.T) {
+	grid := NewGrid[int](3, 2)
+
+	assertEquals(t, grid.InBounds(0, 0), true)
+	assertEquals(t, grid.InBounds(2, 1), true)
+	assertEquals(t, grid.InBounds(3, 0), false)
+	assertEquals(t, grid.InBounds(0, 2), false)
+	assertEquals(t, grid.InBounds(-1, 0), false)
+	assertEquals(t, grid.InBounds(0, -1), false)
+
+	assertPanics(t, func() { grid.Get(3, 0) })
+	assertPanics(t, func() { grid.Set(0, -1, 1) })
+	assertPanics(t, func() { grid.Neighbors(0, 2, false) })
+
+	empty := NewGrid[int](-1, 4)
+	assertEquals(t, empty.Width(), 0)
+	assertEquals(t, empty.InBounds(0, 0), false)
+}
+
+func TestGrid_Neighbors(t *testing.T) {
+	grid := NewGrid[int](3, 3)
+
+	// Centre cell
+	assertEquals(t, slices.Equal(grid.Neighbors(1, 1, false), []Point{{1, 0}, {0, 1}, {2, 1}, {1, 2}}), true)
+	assertEquals(t, len(grid.Neighbors(1, 1, true)), 8)
+
+	// Corner cell
+	assertEquals(t, slices.Equal(grid.Neighbors(0, 0, false), []Point{{1, 0}, {0, 1}}), true)
+	assertEquals(t, slices.Equal(grid.Neighbors(0, 0, true), []Point{{1, 0}, {0, 1}, {1, 1}}), true)
+	assertEquals(t, slices.Equal(grid.Neighbors(2, 2, true), []Point{{1, 1}, {2, 1}, {1, 2}}), true)
+
+	// Edge cell
+	assertEquals(t, slices.Equal(grid.Neighbors(1, 0, false), []Point{{0, 0}, {2, 0}, {1, 1}}), true)
+	assertEquals(t, len(grid.Neighbors(1, 0, true)), 5)
+
+	// Single cell grid has no neighbours
+	single := NewGrid[int](1, 1)
+	assertEquals(t, len(single.Neighbors(0, 0, true)), 0)
+}
+
+func assertPanics(t *testing.T, fn func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("failed to panic")
+		}
+	}()
+	fn()
+}
+
+func assertEquals[V comparable](t *testing.T, got, want V) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,197 @@
+package intervaltree
+
+import "cmp"
+
+// Interval represents a closed range [Low, High] with an associated payload.
+type Interval[T cmp.Ordered] struct {
+	Low     T
+	High    T
+	Payload any
+}
+
+// IntervalTree stores intervals for efficient overlap queries.
+// It is an AVL tree ordered by interval start, where each node is augmented with the
+// largest High in its subtree so that queries can skip subtrees that cannot overlap.
+// Intervals are closed, so [1, 3] and [3, 5] overlap at 3.
+// The zero value is ready to use.
+type IntervalTree[T cmp.Ordered] struct {
+	root *node[T]
+	size int
+}
+
+type node[T cmp.Ordered] struct {
+	interval    Interval[T]
+	maxHigh     T
+	height      int
+	left, right *node[T]
+}
+
+// NewIntervalTree creates and returns an empty interval tree.
+//
+// Example:
+//
+//	tree := NewIntervalTree[int]()
+//	tree.Insert(1, 5, "meeting")
+func NewIntervalTree[T cmp.Ordered]() *IntervalTree[T] {
+	return &IntervalTree[T]{}
+}
+
+// Insert adds the interval [low, high] with its payload to the tree in O(log n).
+// If low is greater than high, the bounds are swapped.
+// Duplicate intervals are kept as separate entries.
+//
+// Example:
+//
+//	tree := NewIntervalTree[int]()
+//	tree.Insert(1, 5, "a")
+//	tree.Insert(3, 8, "b")
+func (t *IntervalTree[T]) Insert(low, high T, payload any) {
+	if low > high {
+		low, high = high, low
+	}
+
+	t.root = insert(t.root, Interval[T]{Low: low, High: high, Payload: payload})
+	t.size++
+}
+
+// Len returns the number of intervals in the tree.
+//
+// Example:
+//
+//	tree := NewIntervalTree[int]()
+//	tree.Insert(1, 5, nil)
+//	fmt.Println(tree.Len()) // Output: 1
+func (t *IntervalTree[T]) Len() int {
+	return t.size
+}
+
+// Stabbing returns every interval that contains point, ordered by Low then High.
+//
+// Example:
+//
+//	tree := NewIntervalTree[int]()
+//	tree.Insert(1, 5, "a")
+//	tree.Insert(6, 8, "b")
+//	fmt.Println(len(tree.Stabbing(3))) // Output: 1
+func (t *IntervalTree[T]) Stabbing(point T) []Interval[T] {
+	return t.Overlapping(point, point)
+}
+
+// Overlapping returns every interval that shares at least one point with [low, high],
+// ordered by Low then High. If low is greater than high, the bounds are swapped.
+//
+// Example:
+//
+//	tree := NewIntervalTree[int]()
+//	tree.Insert(1, 5, "a")
+//	tree.Insert(6, 8, "b")
+//	tree.Insert(10, 12, "c")
+//	fmt.Println(len(tree.Overlapping(4, 7))) // Output: 2
+func (t *IntervalTree[T]) Overlapping(low, high T) []Interval[T] {
+	if low > high {
+		low, high = high, low
+	}
+
+	result := make([]Interval[T], 0)
+	collect(t.root, low, high, &result)
+	return result
+}
+
+// collect appends the overlapping intervals of n's subtree to result in order.
+func collect[T cmp.Ordered](n *node[T], low, high T, result *[]Interval[T]) {
+	// nothing in this subtree ends at or after low
+	if n == nil || n.maxHigh < low {
+		return
+	}
+
+	collect(n.left, low, high, result)
+
+	// this node and everything to its right starts after high
+	if n.interval.Low > high {
+		return
+	}
+	if n.interval.High >= low {
+		*result = append(*result, n.interval)
+	}
+
+	collect(n.right, low, high, result)
+}
+
+func insert[T cmp.Ordered](n *node[T], interval Interval[T]) *node[T] {
+	if n == nil {
+		return &node[T]{interval: interval, maxHigh: interval.High, height: 1}
+	}
+
+	if less(interval, n.interval) {
+		n.left = insert(n.left, interval)
+	} else {
+		n.right = insert(n.right, interval)
+	}
+
+	return rebalance(n)
+}
+
+func less[T cmp.Ordered](a, b Interval[T]) bool {
+	if a.Low != b.Low {
+		return a.Low < b.Low
+	}
+	return a.High < b.High
+}
+
+func height[T cmp.Ordered](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height and subtree maximum of n from its children.
+func update[T cmp.Ordered](n *node[T]) {
+	n.height = 1 + max(height(n.left), height(n.right))
+	n.maxHigh = n.interval.High
+	if n.left != nil {
+		n.maxHigh = max(n.maxHigh, n.left.maxHigh)
+	}
+	if n.right != nil {
+		n.maxHigh = max(n.maxHigh, n.right.maxHigh)
+	}
+}
+
+func rotateLeft[T cmp.Ordered](n *node[T]) *node[T] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	update(n)
+	update(r)
+	return r
+}
+
+func rotateRight[T cmp.Ordered](n *node[T]) *node[T] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	update(n)
+	update(l)
+	return l
+}
+
+func rebalance[T cmp.Ordered](n *node[T]) *node[T] {
+	update(n)
+	balance := height(n.left) - height(n.right)
+
+	if balance > 1 {
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	}
+
+	if balance < -1 {
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+
+	return n
+}
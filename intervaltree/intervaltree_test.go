@@ -0,0 +1,76 @@
+package intervaltree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIntervalTree_Stabbing(t *testing.T) {
+	tree := NewIntervalTree[int]()
+	assertEquals(t, len(tree.Stabbing(1)), 0)
+
+	// Nested intervals
+	tree.Insert(1, 10, "outer")
+	tree.Insert(3, 6, "middle")
+	tree.Insert(4, 5, "inner")
+	// Adjacent intervals
+	tree.Insert(20, 25, "left")
+	tree.Insert(25, 30, "right")
+	// Disjoint interval
+	tree.Insert(40, 50, "far")
+	assertEquals(t, tree.Len(), 6)
+
+	assertEquals(t, payloads(tree.Stabbing(4)), "[outer middle inner]")
+	assertEquals(t, payloads(tree.Stabbing(2)), "[outer]")
+	assertEquals(t, payloads(tree.Stabbing(10)), "[outer]")
+	assertEquals(t, payloads(tree.Stabbing(11)), "[]")
+	assertEquals(t, payloads(tree.Stabbing(25)), "[left right]")
+	assertEquals(t, payloads(tree.Stabbing(26)), "[right]")
+	assertEquals(t, payloads(tree.Stabbing(45)), "[far]")
+	assertEquals(t, payloads(tree.Stabbing(0)), "[]")
+	assertEquals(t, payloads(tree.Stabbing(51)), "[]")
+}
+
+func TestIntervalTree_Overlapping(t *testing.T) {
+	tree := NewIntervalTree[int]()
+	tree.Insert(1, 10, "outer")
+	tree.Insert(3, 6, "middle")
+	tree.Insert(20, 25, "left")
+	tree.Insert(25, 30, "right")
+	tree.Insert(40, 50, "far")
+
+	assertEquals(t, payloads(tree.Overlapping(7, 9)), "[outer]")
+	assertEquals(t, payloads(tree.Overlapping(5, 22)), "[outer middle left]")
+	assertEquals(t, payloads(tree.Overlapping(11, 19)), "[]")
+	assertEquals(t, payloads(tree.Overlapping(30, 40)), "[right far]")
+	assertEquals(t, payloads(tree.Overlapping(0, 100)), "[outer middle left right far]")
+	assertEquals(t, payloads(tree.Overlapping(22, 21)), "[left]")
+}
+
+func TestIntervalTree_Balanced(t *testing.T) {
+	tree := NewIntervalTree[int]()
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i, i+1, i)
+	}
+
+	assertEquals(t, tree.Len(), 1000)
+	if tree.root.height > 15 {
+		t.Errorf("tree height %d is not balanced", tree.root.height)
+	}
+	assertEquals(t, payloads(tree.Stabbing(500)), "[499 500]")
+}
+
+func payloads(intervals []Interval[int]) string {
+	result := make([]any, 0, len(intervals))
+	for _, interval := range intervals {
+		result = append(result, interval.Payload)
+	}
+	return fmt.Sprint(result)
+}
+
+func assertEquals[V comparable](t *testing.T, got, want V) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,215 @@
+package queue
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for a DelayQueue. Replacing it with a fake lets tests control
+// when items become ready without sleeping.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel that receives once d has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the Clock used by default, backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// DelayQueue holds items until their ready time has arrived, then releases them earliest ready
+// time first. Items with the same ready time are released in the order they were put.
+// Unlike Queue, DelayQueue is thread-safe, so producers and consumers may share it.
+// The zero value is not usable; use NewDelayQueue to create a new DelayQueue.
+type DelayQueue[T any] struct {
+	items delayHeap[T]
+	seq   uint64
+	clock Clock
+	// wake is closed and replaced whenever an item is put, so blocked Take calls re-check the front
+	wake chan struct{}
+	mu   sync.Mutex
+}
+
+// NewDelayQueue creates and returns an empty DelayQueue using the system clock.
+//
+// Example:
+//
+//	q := NewDelayQueue[string]()
+//	q.Put("retry", time.Now().Add(time.Second))
+func NewDelayQueue[T any]() *DelayQueue[T] {
+	return &DelayQueue[T]{
+		clock: realClock{},
+		wake:  make(chan struct{}),
+	}
+}
+
+// SetClock replaces the clock used to decide when items are ready, which is useful in tests.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	q := NewDelayQueue[string]()
+//	q.SetClock(fakeClock)
+func (q *DelayQueue[T]) SetClock(clock Clock) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.clock = clock
+	q.notify()
+}
+
+// Put adds an item that becomes ready at readyAt. A readyAt in the past makes it ready immediately.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	q := NewDelayQueue[string]()
+//	q.Put("send reminder", time.Now().Add(time.Hour))
+func (q *DelayQueue[T]) Put(item T, readyAt time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	heap.Push(&q.items, delayItem[T]{item: item, readyAt: readyAt, seq: q.seq})
+	q.seq++
+	q.notify()
+}
+
+// Poll removes and returns the item with the earliest ready time, if that time has arrived.
+// Returns the item and true if successful, or zero value and false if the queue is empty or no
+// item is ready yet. Poll never blocks.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	q := NewDelayQueue[string]()
+//	q.Put("now", time.Now())
+//	q.Put("later", time.Now().Add(time.Hour))
+//	val, ok := q.Poll() // val = "now", ok = true
+//	val, ok = q.Poll()  // val = "", ok = false ("later" is not ready)
+func (q *DelayQueue[T]) Poll() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	item, ok, _ := q.pollLocked()
+	return item, ok
+}
+
+// Take removes and returns the item with the earliest ready time, blocking until one is ready.
+// Items put while Take is waiting are taken into account, so an earlier item put later is not
+// held up behind the one Take was waiting for.
+// Returns ctx.Err() if ctx is done before an item becomes ready.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	q := NewDelayQueue[string]()
+//	q.Put("retry", time.Now().Add(time.Second))
+//	val, err := q.Take(ctx) // blocks for about a second, then val = "retry", err = nil
+func (q *DelayQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		item, ok, wait := q.pollLocked()
+		wake := q.wake
+		clock := q.clock
+		q.mu.Unlock()
+		if ok {
+			return item, nil
+		}
+
+		// an empty queue waits for a Put; otherwise wait until the front item is due
+		var timer <-chan time.Time
+		if wait > 0 {
+			timer = clock.After(wait)
+		}
+
+		select {
+		case <-ctx.Done():
+			var empty T
+			return empty, ctx.Err()
+		case <-wake:
+		case <-timer:
+		}
+	}
+}
+
+// Len returns the number of items in the queue, ready or not.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	q := NewDelayQueue[string]()
+//	q.Put("later", time.Now().Add(time.Hour))
+//	fmt.Println(q.Len()) // Output: 1
+func (q *DelayQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+// pollLocked pops the front item if it is ready. Otherwise it returns how long until the front
+// item is ready, or 0 if the queue is empty. The caller must hold the lock.
+func (q *DelayQueue[T]) pollLocked() (T, bool, time.Duration) {
+	var empty T
+	if len(q.items) == 0 {
+		return empty, false, 0
+	}
+
+	wait := q.items[0].readyAt.Sub(q.clock.Now())
+	if wait > 0 {
+		return empty, false, wait
+	}
+
+	item := heap.Pop(&q.items).(delayItem[T])
+	return item.item, true, 0
+}
+
+// notify wakes every blocked Take. The caller must hold the lock.
+func (q *DelayQueue[T]) notify() {
+	close(q.wake)
+	q.wake = make(chan struct{})
+}
+
+type delayItem[T any] struct {
+	item    T
+	readyAt time.Time
+	seq     uint64
+}
+
+// delayHeap is a min-heap of items ordered by ready time, then by insertion order.
+type delayHeap[T any] []delayItem[T]
+
+func (h delayHeap[T]) Len() int {
+	return len(h)
+}
+
+func (h delayHeap[T]) Less(i, j int) bool {
+	if !h[i].readyAt.Equal(h[j].readyAt) {
+		return h[i].readyAt.Before(h[j].readyAt)
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h delayHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *delayHeap[T]) Push(x any) {
+	*h = append(*h, x.(delayItem[T]))
+}
+
+func (h *delayHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	// release the popped slot, as it stays in the backing array
+	old[n-1] = delayItem[T]{}
+	*h = old[:n-1]
+	return item
+}
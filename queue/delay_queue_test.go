@@ -0,0 +1,189 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock whose time only moves when Advance is called.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	c.waiters = append(c.waiters, fakeWaiter{deadline: c.now.Add(d), ch: ch})
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.deadline.After(c.now) {
+			pending = append(pending, w)
+			continue
+		}
+		w.ch <- c.now
+	}
+	c.waiters = pending
+}
+
+// waitForWaiters blocks until n After calls are pending, so a test knows Take is asleep.
+func (c *fakeClock) waitForWaiters(t *testing.T, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		c.mu.Lock()
+		waiting := len(c.waiters)
+		c.mu.Unlock()
+		if waiting >= n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d waiters", n)
+}
+
+func TestDelayQueue_Poll(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueue[string]()
+	queue.SetClock(clock)
+	start := clock.Now()
+
+	v, ok := queue.Poll()
+	assertEquals(t, ok, false)
+	assertEquals(t, v, "")
+
+	queue.Put("c", start.Add(3*time.Second))
+	queue.Put("a", start.Add(time.Second))
+	queue.Put("b", start.Add(2*time.Second))
+	queue.Put("a2", start.Add(time.Second))
+	assertEquals(t, queue.Len(), 4)
+
+	_, ok = queue.Poll()
+	assertEquals(t, ok, false)
+
+	clock.Advance(time.Second)
+	v, ok = queue.Poll()
+	assertEquals(t, ok, true)
+	assertEquals(t, v, "a")
+	v, _ = queue.Poll()
+	assertEquals(t, v, "a2")
+	_, ok = queue.Poll()
+	assertEquals(t, ok, false)
+
+	// Several items becoming ready together come out in ready-time order
+	clock.Advance(5 * time.Second)
+	v, _ = queue.Poll()
+	assertEquals(t, v, "b")
+	v, _ = queue.Poll()
+	assertEquals(t, v, "c")
+	assertEquals(t, queue.Len(), 0)
+
+	// Items in the past are ready immediately
+	queue.Put("late", start)
+	v, ok = queue.Poll()
+	assertEquals(t, ok, true)
+	assertEquals(t, v, "late")
+}
+
+func TestDelayQueue_Take(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueue[string]()
+	queue.SetClock(clock)
+	queue.Put("later", clock.Now().Add(time.Minute))
+
+	result := make(chan string)
+	go func() {
+		v, err := queue.Take(context.Background())
+		assertEquals(t, err, nil)
+		result <- v
+	}()
+
+	clock.waitForWaiters(t, 1)
+	clock.Advance(30 * time.Second)
+	select {
+	case v := <-result:
+		t.Fatalf("Take returned %q before the item was ready", v)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	clock.Advance(30 * time.Second)
+	assertEquals(t, <-result, "later")
+	assertEquals(t, queue.Len(), 0)
+}
+
+func TestDelayQueue_Take_WokenByPut(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueue[string]()
+	queue.SetClock(clock)
+	queue.Put("later", clock.Now().Add(time.Hour))
+
+	result := make(chan string)
+	go func() {
+		v, _ := queue.Take(context.Background())
+		result <- v
+	}()
+
+	// An item that is already ready does not wait behind the later one
+	clock.waitForWaiters(t, 1)
+	queue.Put("now", clock.Now())
+	assertEquals(t, <-result, "now")
+	assertEquals(t, queue.Len(), 1)
+
+	// Take on an empty queue waits for a Put
+	empty := NewDelayQueue[int]()
+	empty.SetClock(clock)
+	ints := make(chan int)
+	go func() {
+		v, _ := empty.Take(context.Background())
+		ints <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+	empty.Put(7, clock.Now())
+	assertEquals(t, <-ints, 7)
+}
+
+func TestDelayQueue_Take_Cancelled(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueue[string]()
+	queue.SetClock(clock)
+	queue.Put("later", clock.Now().Add(time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		_, err := queue.Take(ctx)
+		errs <- err
+	}()
+
+	clock.waitForWaiters(t, 1)
+	cancel()
+	assertEquals(t, errors.Is(<-errs, context.Canceled), true)
+	assertEquals(t, queue.Len(), 1)
+}
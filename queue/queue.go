@@ -1,10 +1,16 @@
 package queue
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 )
 
+// ErrEmptyQueue is returned by DequeueErr when the queue has no elements.
+var ErrEmptyQueue = errors.New("queue is empty")
+
 // Queue represents a generic FIFO queue data structure.
 // Elements are added to the back and removed from the front.
 // The zero value is not usable; use NewQueue to create a new Queue.
@@ -13,6 +19,18 @@ type Queue[T any] struct {
 
 	preventDuplicates bool
 	equalsFunc func(a, b T) bool
+
+	watermarks *watermarks
+
+	// deadFront counts the slots before elements[0] in the backing array, left behind by dequeues
+	deadFront  int
+	slackRatio float64
+}
+
+// watermarks holds the length thresholds and callbacks configured by SetWatermarks.
+type watermarks struct {
+	low, high     int
+	onHigh, onLow func()
 }
 
 // NewQueue creates and returns an empty queue that can store elements of type T.
@@ -53,6 +71,129 @@ func (q *Queue[T]) PreventDuplicates(equalsFunc func(a, b T) bool) error {
 	return nil
 }
 
+// SetEqualsFunc replaces the comparison used for duplicate prevention.
+// Elements already in the queue are left as they are; only subsequent enqueues are checked
+// against the new comparison. It has no effect on enqueues until PreventDuplicates is enabled.
+//
+// Example:
+//
+//	q := NewQueue[ContactUser]()
+//	q.PreventDuplicates(func(a, b ContactUser) bool {
+//	    return a == b
+//	})
+//	q.SetEqualsFunc(func(a, b ContactUser) bool {
+//	    return a.ID == b.ID
+//	})
+func (q *Queue[T]) SetEqualsFunc(equalsFunc func(a, b T) bool) {
+	q.equalsFunc = equalsFunc
+}
+
+// Clone returns an independent copy of the queue, including its duplicate prevention settings.
+// The clone shares no state with the original: changes to one, including which elements
+// count as duplicates, never affect the other. Elements themselves are copied by value.
+//
+// The clone's backing slice is allocated with the same capacity as the original's, so both
+// queues have the same allocation behaviour as they grow, e.g. when benchmarking against a clone.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	c := q.Clone()
+//	c.Enqueue(2)
+//	fmt.Println(q.Length(), c.Length()) // Output: 1 2
+func (q *Queue[T]) Clone() *Queue[T] {
+	elements := make([]T, len(q.elements), cap(q.elements))
+	copy(elements, q.elements)
+
+	return &Queue[T]{
+		elements:          elements,
+		preventDuplicates: q.preventDuplicates,
+		equalsFunc:        q.equalsFunc,
+		slackRatio:        q.slackRatio,
+	}
+}
+
+// SetWatermarks registers callbacks that fire when the queue's length crosses a threshold, e.g.
+// to scale workers up and down. onHigh fires when the length rises from below high to high or
+// above, and onLow fires when it drops from above low to low or below. Each fires once per
+// crossing, not on every change while the length stays beyond the threshold.
+// Callbacks run synchronously after the change that caused the crossing; either may be nil.
+// Calling SetWatermarks again replaces the previous configuration. Clones do not inherit it.
+//
+// Example:
+//
+//	q := NewQueue[Job]()
+//	q.SetWatermarks(10, 1000, pool.ScaleUp, pool.ScaleDown)
+func (q *Queue[T]) SetWatermarks(low, high int, onHigh, onLow func()) {
+	q.watermarks = &watermarks{
+		low:    low,
+		high:   high,
+		onHigh: onHigh,
+		onLow:  onLow,
+	}
+}
+
+// checkWatermarks fires the watermark callbacks for any threshold crossed since the queue had
+// prevLength elements. It is deferred by every method that changes the length directly.
+func (q *Queue[T]) checkWatermarks(prevLength int) {
+	w := q.watermarks
+	if w == nil {
+		return
+	}
+
+	length := q.Length()
+	if prevLength < w.high && length >= w.high && w.onHigh != nil {
+		w.onHigh()
+	}
+	if prevLength > w.low && length <= w.low && w.onLow != nil {
+		w.onLow()
+	}
+}
+
+// SetCompactionPolicy makes the queue copy its elements into a right-sized backing array once
+// the slots left behind by dequeues exceed slackRatio of the backing array's capacity.
+// Dequeuing only advances the front of the slice, so without compaction a long-lived queue keeps
+// its peak allocation, and the dequeued elements stay reachable, until it is next emptied.
+// A lower ratio frees memory sooner at the cost of more copying; a ratio of 0 or less, the
+// default, disables compaction. The policy is inherited by clones.
+//
+// Example:
+//
+//	q := NewQueue[Job]()
+//	q.SetCompactionPolicy(0.5) // compact once over half the backing array is dead space
+func (q *Queue[T]) SetCompactionPolicy(slackRatio float64) {
+	q.slackRatio = slackRatio
+	q.compactIfSlack()
+}
+
+// compactIfSlack copies the elements into a new backing array if the compaction policy's
+// slack threshold has been exceeded.
+func (q *Queue[T]) compactIfSlack() {
+	if q.slackRatio <= 0 || q.deadFront == 0 {
+		return
+	}
+
+	// reslicing from the front keeps the end of the backing array, so its capacity is recoverable
+	backingCap := q.deadFront + cap(q.elements)
+	if float64(q.deadFront) <= q.slackRatio*float64(backingCap) {
+		return
+	}
+
+	elements := make([]T, len(q.elements))
+	copy(elements, q.elements)
+	q.elements = elements
+	q.deadFront = 0
+}
+
+// reserve must be called before adding an element. If the backing array is full, the element
+// will be appended to a new array, leaving the dead front slots behind with the old one.
+func (q *Queue[T]) reserve() {
+	if len(q.elements) == cap(q.elements) {
+		q.deadFront = 0
+	}
+}
+
 // Enqueue adds an element to the back of the queue.
 //
 // Example:
@@ -61,15 +202,99 @@ func (q *Queue[T]) PreventDuplicates(equalsFunc func(a, b T) bool) error {
 //	q.Enqueue(1) // queue now contains: [1]
 //	q.Enqueue(2) // queue now contains: [1, 2]
 func (q *Queue[T]) Enqueue(element T) {
-	if q.preventDuplicates {
-		for _, e := range q.elements {
-			if q.equalsFunc(element, e) {
-				return
-			}
-		}
+	q.EnqueueUnique(element)
+}
+
+// EnqueueUnique adds an element to the back of the queue and reports whether it was added.
+// Returns false if duplicate prevention is enabled and the element is a duplicate, true otherwise.
+// When duplicate prevention is disabled the element is always added and true is returned.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.PreventDuplicates(func(a, b int) bool { return a == b })
+//	fmt.Println(q.EnqueueUnique(1)) // Output: true
+//	fmt.Println(q.EnqueueUnique(1)) // Output: false
+func (q *Queue[T]) EnqueueUnique(element T) bool {
+	defer q.checkWatermarks(q.Length())
+
+	if q.isDuplicate(element) {
+		return false
 	}
 
+	q.reserve()
 	q.elements = append(q.elements, element)
+
+	return true
+}
+
+// RequeueFront puts an element back at the front of the queue, so it is the next to be dequeued,
+// e.g. to retry it after a failed attempt. The element is rejected if the queue already holds
+// maxInFlight or more elements, which stops a repeatedly failing element from growing the queue
+// without bound. Duplicate prevention applies as it does for Enqueue.
+// Returns true if the element was requeued, false if it was rejected.
+//
+// Example:
+//
+//	q := NewQueue[Job]()
+//	job, _ := q.Dequeue()
+//	if err := job.Run(); err != nil && !q.RequeueFront(job, 100) {
+//		deadLetters.Enqueue(job)
+//	}
+func (q *Queue[T]) RequeueFront(element T, maxInFlight int) bool {
+	if q.Length() >= maxInFlight || q.isDuplicate(element) {
+		return false
+	}
+
+	defer q.checkWatermarks(q.Length())
+
+	q.reserve()
+	q.elements = slices.Insert(q.elements, 0, element)
+
+	return true
+}
+
+// EnqueueSorted inserts an element at the position that keeps the queue sorted ascending by less,
+// so Dequeue keeps returning the smallest element. Equal elements stay in insertion order.
+// The queue is assumed to already be sorted by less, e.g. by only ever using EnqueueSorted.
+// Insertion is O(n), so prefer a heap for large queues.
+// Duplicate prevention applies as it does for Enqueue.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	less := func(a, b int) bool { return a < b }
+//	q.EnqueueSorted(3, less)
+//	q.EnqueueSorted(1, less)
+//	q.EnqueueSorted(2, less) // queue now contains: [1, 2, 3]
+func (q *Queue[T]) EnqueueSorted(element T, less func(a, b T) bool) {
+	defer q.checkWatermarks(q.Length())
+
+	if q.isDuplicate(element) {
+		return
+	}
+
+	q.reserve()
+	i := slices.IndexFunc(q.elements, func(e T) bool {
+		return less(element, e)
+	})
+	if i == -1 {
+		q.elements = append(q.elements, element)
+		return
+	}
+
+	q.elements = slices.Insert(q.elements, i, element)
+}
+
+// isDuplicate reports whether duplicate prevention is enabled and element is already queued.
+func (q *Queue[T]) isDuplicate(element T) bool {
+	if !q.preventDuplicates {
+		return false
+	}
+
+	return slices.ContainsFunc(q.elements, func(e T) bool {
+		return q.equalsFunc(element, e)
+	})
 }
 
 // Dequeue removes and returns the element at the front of the queue.
@@ -84,6 +309,8 @@ func (q *Queue[T]) Enqueue(element T) {
 //	val, ok = q.Dequeue()  // val = 2, ok = true
 //	val, ok = q.Dequeue()  // val = 0, ok = false (queue empty)
 func (q *Queue[T]) Dequeue() (T, bool) {
+	defer q.checkWatermarks(q.Length())
+
 	if q.IsEmpty() {
 		var empty T
 		return empty, false
@@ -94,16 +321,328 @@ func (q *Queue[T]) Dequeue() (T, bool) {
 	if q.Length() == 1 {
 		// Only one element remaining. Reset the queue to prevent memory leaks
 		q.elements = nil
+		q.deadFront = 0
 
 		return element, true
 	}
 
 	// remove element from queue
 	q.elements = q.elements[1:]
+	q.deadFront++
+	q.compactIfSlack()
 
 	return element, true
 }
 
+// DequeueErr removes and returns the element at the front of the queue, like Dequeue, but reports
+// an empty queue as ErrEmptyQueue instead of a bool.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	val, err := q.DequeueErr() // val = 1, err = nil
+//	val, err = q.DequeueErr()  // val = 0, errors.Is(err, ErrEmptyQueue) = true
+func (q *Queue[T]) DequeueErr() (T, error) {
+	element, ok := q.Dequeue()
+	if !ok {
+		return element, ErrEmptyQueue
+	}
+
+	return element, nil
+}
+
+// DequeueIf removes and returns the element at the front of the queue only if pred returns true for it.
+// Returns the element and true if it was removed, or zero value and false if the queue is empty
+// or the front element did not satisfy pred, in which case the queue is left unchanged.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	val, ok := q.DequeueIf(func(v int) bool { return v > 5 }) // val = 0, ok = false, queue still contains: [1]
+//	val, ok = q.DequeueIf(func(v int) bool { return v < 5 })  // val = 1, ok = true
+func (q *Queue[T]) DequeueIf(pred func(front T) bool) (T, bool) {
+	front, ok := q.Peek()
+	if !ok || !pred(front) {
+		var empty T
+		return empty, false
+	}
+
+	return q.Dequeue()
+}
+
+// DequeueMin removes and returns the smallest element according to less, leaving the remaining
+// elements in their original order. If several elements are equally small, the one nearest the
+// front is removed. This scans the whole queue, so it is O(n); it suits a mostly-FIFO queue that
+// only occasionally needs a min-extraction.
+// Returns the element and true if successful, or zero value and false if the queue is empty.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(3)
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	val, ok := q.DequeueMin(func(a, b int) bool { return a < b }) // val = 1, ok = true, queue now contains: [3, 2]
+func (q *Queue[T]) DequeueMin(less func(a, b T) bool) (T, bool) {
+	if q.IsEmpty() {
+		var empty T
+		return empty, false
+	}
+
+	minIndex := 0
+	for i := 1; i < len(q.elements); i++ {
+		if less(q.elements[i], q.elements[minIndex]) {
+			minIndex = i
+		}
+	}
+
+	if minIndex == 0 {
+		return q.Dequeue()
+	}
+
+	defer q.checkWatermarks(q.Length())
+
+	element := q.elements[minIndex]
+	q.elements = slices.Delete(q.elements, minIndex, minIndex+1)
+
+	return element, true
+}
+
+// TakeWhile removes and returns the leading run of elements for which pred returns true, in
+// dequeue order, stopping at the first element that does not satisfy pred.
+// Returns an empty slice, leaving the queue unchanged, if the front element does not satisfy pred.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Enqueue(5)
+//	q.Enqueue(1)
+//	fmt.Println(q.TakeWhile(func(v int) bool { return v < 3 })) // Output: [1 2], queue now contains: [5, 1]
+func (q *Queue[T]) TakeWhile(pred func(T) bool) []T {
+	n := q.leadingRun(pred)
+	taken := slices.Clone(q.elements[:n])
+	q.TrimFront(n)
+
+	return taken
+}
+
+// DropWhile discards the leading run of elements for which pred returns true, stopping at the
+// first element that does not satisfy pred, and returns how many were dropped.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(5)
+//	q.Enqueue(1)
+//	fmt.Println(q.DropWhile(func(v int) bool { return v < 3 })) // Output: 1, queue now contains: [5, 1]
+func (q *Queue[T]) DropWhile(pred func(T) bool) int {
+	n := q.leadingRun(pred)
+	q.TrimFront(n)
+
+	return n
+}
+
+// leadingRun returns the number of elements at the front of the queue that satisfy pred.
+func (q *Queue[T]) leadingRun(pred func(T) bool) int {
+	for i, e := range q.elements {
+		if !pred(e) {
+			return i
+		}
+	}
+
+	return len(q.elements)
+}
+
+// DequeueAll repeatedly dequeues until the queue is empty and returns the elements in dequeue order.
+// Each element goes through Dequeue individually, which makes it useful for exercising the
+// regular dequeue path in tests.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	fmt.Println(q.DequeueAll()) // Output: [1 2]
+//	fmt.Println(q.IsEmpty())    // Output: true
+func (q *Queue[T]) DequeueAll() []T {
+	elements := make([]T, 0, q.Length())
+	for {
+		element, ok := q.Dequeue()
+		if !ok {
+			return elements
+		}
+		elements = append(elements, element)
+	}
+}
+
+// TrimFront drops up to n elements from the front of the queue without returning them.
+// Trimming more elements than the queue holds empties it.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Enqueue(3)
+//	q.TrimFront(2) // queue now contains: [3]
+func (q *Queue[T]) TrimFront(n int) {
+	defer q.checkWatermarks(q.Length())
+
+	if n <= 0 {
+		return
+	}
+
+	if n >= q.Length() {
+		// Reset the queue to prevent memory leaks
+		q.elements = nil
+		q.deadFront = 0
+
+		return
+	}
+
+	q.elements = q.elements[n:]
+	q.deadFront += n
+	q.compactIfSlack()
+}
+
+// TrimBack drops up to n elements from the back of the queue without returning them.
+// Trimming more elements than the queue holds empties it.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Enqueue(3)
+//	q.TrimBack(2) // queue now contains: [1]
+func (q *Queue[T]) TrimBack(n int) {
+	defer q.checkWatermarks(q.Length())
+
+	if n <= 0 {
+		return
+	}
+
+	if n >= q.Length() {
+		// Reset the queue to prevent memory leaks
+		q.elements = nil
+		q.deadFront = 0
+
+		return
+	}
+
+	// release the dropped elements, as their slots stay in the backing array
+	keep := q.Length() - n
+	clear(q.elements[keep:])
+	q.elements = q.elements[:keep]
+}
+
+// SplitBack removes up to n elements from the back of the queue and returns them, in their
+// original order, as a new queue. The original queue keeps the front elements.
+// The new queue inherits the duplicate prevention settings of the original.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Enqueue(3)
+//	back := q.SplitBack(2) // q now contains: [1], back contains: [2, 3]
+func (q *Queue[T]) SplitBack(n int) *Queue[T] {
+	back := NewQueue[T]()
+	back.preventDuplicates = q.preventDuplicates
+	back.equalsFunc = q.equalsFunc
+
+	n = min(max(n, 0), q.Length())
+	if n == 0 {
+		return back
+	}
+
+	keep := q.Length() - n
+	back.elements = append(back.elements, q.elements[keep:]...)
+	q.TrimBack(n)
+
+	return back
+}
+
+// DequeueBatch repeatedly removes up to size elements from the front of the queue and passes them
+// to fn, until the queue is empty or fn returns an error. Every batch is full except possibly the last.
+// A size below 1 is treated as 1. Each batch is a fresh slice that fn may keep.
+// Returns the first error from fn. A batch is only removed once fn has succeeded, so the batch
+// that failed stays at the front of the queue, followed by any elements not yet batched.
+// fn may enqueue further elements, but must not dequeue from the queue.
+//
+// Example:
+//
+//	q := NewQueue[Event]()
+//	q.Enqueue(event)
+//	err := q.DequeueBatch(100, func(batch []Event) error {
+//		return store.InsertAll(batch)
+//	})
+func (q *Queue[T]) DequeueBatch(size int, fn func(batch []T) error) error {
+	size = max(size, 1)
+	for !q.IsEmpty() {
+		n := min(size, q.Length())
+		batch := slices.Clone(q.elements[:n])
+		if err := fn(batch); err != nil {
+			return err
+		}
+
+		q.TrimFront(n)
+	}
+
+	return nil
+}
+
+// FlushWithRetry dequeues elements one at a time and passes each to fn, until the queue is empty.
+// When fn returns an error the element is enqueued again at the back of the queue, and retried
+// up to maxAttempts calls in total before it is given up on. A maxAttempts below 1 is treated as 1.
+// fn may enqueue further elements onto the queue, which are flushed too, but must not dequeue
+// or reorder it, as retries are recognised by their position. If duplicate prevention rejects
+// a retry because an equal element is already queued, the two are treated as one.
+// Returns nil if every element eventually succeeded, or the errors of the elements that were
+// given up on, joined with errors.Join. Elements that have not been passed to fn stay queued
+// if fn panics.
+//
+// Example:
+//
+//	q := NewQueue[Job]()
+//	q.Enqueue(job)
+//	err := q.FlushWithRetry(func(j Job) error {
+//		return j.Run()
+//	}, 3)
+func (q *Queue[T]) FlushWithRetry(fn func(T) error, maxAttempts int) error {
+	// failures counts the failed attempts of retried elements, keyed by their position in
+	// dequeue order since the flush started
+	failures := make(map[int]int)
+
+	var errs []error
+	for position := 0; !q.IsEmpty(); position++ {
+		element, _ := q.Dequeue()
+		attempts := failures[position] + 1
+		delete(failures, position)
+
+		err := fn(element)
+		if err == nil {
+			continue
+		}
+
+		if attempts < maxAttempts {
+			if q.EnqueueUnique(element) {
+				failures[position+q.Length()] = attempts
+			}
+			continue
+		}
+		errs = append(errs, fmt.Errorf("giving up after %d attempts: %w", attempts, err))
+	}
+
+	return errors.Join(errs...)
+}
+
 // Length returns the number of elements currently in the queue.
 //
 // Example:
@@ -144,3 +683,276 @@ func (q *Queue[T]) Peek() (T, bool) {
 
 	return q.elements[0], true
 }
+
+// At returns the element at position i without removing it.
+// Non-negative indices count from the front (At(0) is the front), and negative indices count
+// from the back (At(-1) is the back), as in Python.
+// Returns the element and true if successful, or zero value and false if i is out of range.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Enqueue(3)
+//	val, ok := q.At(0)  // val = 1, ok = true
+//	val, ok = q.At(-1)  // val = 3, ok = true
+//	val, ok = q.At(-4)  // val = 0, ok = false
+func (q *Queue[T]) At(i int) (T, bool) {
+	if i < 0 {
+		i += q.Length()
+	}
+
+	if i < 0 || i >= q.Length() {
+		var empty T
+		return empty, false
+	}
+
+	return q.elements[i], true
+}
+
+// PeekOffset returns the element k positions behind the front without removing anything, so
+// PeekOffset(0) is equivalent to Peek. Unlike At, negative offsets are not counted from the back.
+// Returns the element and true if successful, or zero value and false if k is out of range.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	val, ok := q.PeekOffset(1) // val = 2, ok = true
+//	val, ok = q.PeekOffset(2)  // val = 0, ok = false
+func (q *Queue[T]) PeekOffset(k int) (T, bool) {
+	if k < 0 {
+		var empty T
+		return empty, false
+	}
+
+	return q.At(k)
+}
+
+// PeekRef returns a pointer to the element at the front of the queue without removing or copying it.
+// Returns the pointer and true if successful, or nil and false if the queue is empty.
+//
+// The pointer refers to the queue's backing storage and is only valid until the queue is next
+// modified. Do not retain it across Enqueue, Dequeue or any other mutation, and do not write
+// through it; doing so may corrupt the queue or observe a different element.
+//
+// Example:
+//
+//	q := NewQueue[LargeStruct]()
+//	q.Enqueue(LargeStruct{ID: 1})
+//	ref, ok := q.PeekRef() // ref.ID = 1, ok = true, nothing copied
+func (q *Queue[T]) PeekRef() (*T, bool) {
+	if q.IsEmpty() {
+		return nil, false
+	}
+
+	return &q.elements[0], true
+}
+
+// Each calls fn for each element from front to back with its front-relative position,
+// stopping early if fn returns false. The queue is not modified.
+//
+// Example:
+//
+//	q := NewQueue[string]()
+//	q.Enqueue("a")
+//	q.Enqueue("b")
+//	q.Each(func(i int, v string) bool {
+//		fmt.Println(i, v) // Output: 0 a, 1 b
+//		return true
+//	})
+func (q *Queue[T]) Each(fn func(index int, element T) bool) {
+	for i, e := range q.elements {
+		if !fn(i, e) {
+			return
+		}
+	}
+}
+
+// IndexOf returns the front-relative position of the first element matching pred, or -1 if none match.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(10)
+//	q.Enqueue(20)
+//	fmt.Println(q.IndexOf(func(v int) bool { return v == 20 })) // Output: 1
+func (q *Queue[T]) IndexOf(pred func(T) bool) int {
+	for i, e := range q.elements {
+		if pred(e) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Contains returns true if any element in the queue matches pred, false otherwise.
+// This works for any T, regardless of whether duplicate prevention is enabled.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(10)
+//	fmt.Println(q.Contains(func(v int) bool { return v == 10 })) // Output: true
+//	fmt.Println(q.Contains(func(v int) bool { return v == 30 })) // Output: false
+func (q *Queue[T]) Contains(pred func(T) bool) bool {
+	return q.IndexOf(pred) != -1
+}
+
+// Reverse reverses the order of the elements in place, so the former back becomes the new front.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Reverse() // queue now contains: [2, 1]
+func (q *Queue[T]) Reverse() {
+	slices.Reverse(q.elements)
+}
+
+// Dedup collapses each run of consecutive equal elements into its first element, like the Unix
+// uniq command, and returns how many elements were removed. Equal elements that are not adjacent
+// are left alone; use PreventDuplicates to keep the queue free of all duplicates.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	for _, v := range []int{1, 1, 2, 1, 1, 1} {
+//		q.Enqueue(v)
+//	}
+//	removed := q.Dedup(func(a, b int) bool { return a == b }) // removed = 3, queue now contains: [1, 2, 1]
+func (q *Queue[T]) Dedup(equals func(a, b T) bool) int {
+	before := q.Length()
+	q.elements = slices.CompactFunc(q.elements, equals)
+	removed := before - q.Length()
+	if removed > 0 {
+		q.checkWatermarks(before)
+	}
+
+	return removed
+}
+
+// RewriteAction tells Rewrite what to do with an element.
+type RewriteAction int
+
+const (
+	// Keep leaves the element in the queue unchanged.
+	Keep RewriteAction = iota
+	// Drop removes the element from the queue.
+	Drop
+	// Replace swaps the element for the value returned alongside the action.
+	Replace
+)
+
+// Rewrite calls fn for each element from front to back and rebuilds the queue according to the
+// action it returns: Keep leaves the element, Drop removes it, and Replace substitutes the returned
+// value in the same position. The returned value is ignored for Keep and Drop.
+// If duplicate prevention is enabled and a replacement makes two elements equal, only the one
+// nearest the front is kept, so the queue stays free of duplicates.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Enqueue(3)
+//	q.Rewrite(func(v int) (int, RewriteAction) {
+//		switch {
+//		case v == 1:
+//			return 0, Drop
+//		case v == 2:
+//			return 20, Replace
+//		}
+//		return 0, Keep
+//	}) // queue now contains: [20, 3]
+func (q *Queue[T]) Rewrite(fn func(T) (newVal T, action RewriteAction)) {
+	defer q.checkWatermarks(q.Length())
+
+	kept := q.elements[:0]
+	for _, e := range q.elements {
+		newVal, action := fn(e)
+		switch action {
+		case Drop:
+			continue
+		case Replace:
+			e = newVal
+		}
+		if q.preventDuplicates && slices.ContainsFunc(kept, func(k T) bool { return q.equalsFunc(e, k) }) {
+			continue
+		}
+		kept = append(kept, e)
+	}
+
+	// release the dropped elements, as their slots stay in the backing array
+	clear(q.elements[len(kept):])
+	q.elements = kept
+}
+
+// GroupBy drains q, distributing its elements into a new queue per key.
+// Elements keep their relative FIFO order within each group, and q is left empty.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	q.Enqueue(3)
+//	groups := GroupBy(q, func(v int) bool { return v%2 == 0 })
+//	fmt.Println(groups[false].Length()) // Output: 2 (contains: [1, 3])
+//	fmt.Println(groups[true].Length())  // Output: 1 (contains: [2])
+func GroupBy[T any, K comparable](q *Queue[T], key func(T) K) map[K]*Queue[T] {
+	groups := make(map[K]*Queue[T])
+	for _, element := range q.DequeueAll() {
+		k := key(element)
+		group, ok := groups[k]
+		if !ok {
+			group = NewQueue[T]()
+			groups[k] = group
+		}
+		group.Enqueue(element)
+	}
+
+	return groups
+}
+
+// Min returns the smallest element in the queue without removing it or changing the order.
+// Returns the element and true if successful, or zero value and false if the queue is empty.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(3)
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	val, ok := Min(q) // val = 1, ok = true
+func Min[T cmp.Ordered](q *Queue[T]) (T, bool) {
+	if q.IsEmpty() {
+		var empty T
+		return empty, false
+	}
+
+	return slices.Min(q.elements), true
+}
+
+// Max returns the largest element in the queue without removing it or changing the order.
+// Returns the element and true if successful, or zero value and false if the queue is empty.
+//
+// Example:
+//
+//	q := NewQueue[int]()
+//	q.Enqueue(3)
+//	q.Enqueue(1)
+//	q.Enqueue(2)
+//	val, ok := Max(q) // val = 3, ok = true
+func Max[T cmp.Ordered](q *Queue[T]) (T, bool) {
+	if q.IsEmpty() {
+		var empty T
+		return empty, false
+	}
+
+	return slices.Max(q.elements), true
+}
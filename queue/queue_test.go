@@ -1,6 +1,9 @@
 package queue
 
 import (
+	"errors"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -91,6 +94,964 @@ func TestQueue_PreventDuplicates(t *testing.T) {
 	}
 }
 
+func TestQueue_EnqueueUnique(t *testing.T) {
+	queue := NewQueue[int]()
+	assertEquals(t, queue.EnqueueUnique(1), true)
+	assertEquals(t, queue.EnqueueUnique(1), true)
+	assertEquals(t, queue.Length(), 2)
+
+	unique := NewQueue[int]()
+	err := unique.PreventDuplicates(func(a, b int) bool { return a == b })
+	assertEquals(t, err, nil)
+
+	assertEquals(t, unique.EnqueueUnique(1), true)
+	assertEquals(t, unique.EnqueueUnique(2), true)
+	assertEquals(t, unique.EnqueueUnique(1), false)
+	assertEquals(t, unique.Length(), 2)
+
+	unique.Dequeue()
+	assertEquals(t, unique.EnqueueUnique(1), true)
+	assertEquals(t, unique.Length(), 2)
+}
+
+func TestQueue_RequeueFront(t *testing.T) {
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+
+	v, _ := queue.Dequeue()
+	assertEquals(t, queue.RequeueFront(v, 3), true)
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []int{1, 2}), true)
+
+	queue.Enqueue(3)
+	assertEquals(t, queue.RequeueFront(4, 3), false)
+	assertEquals(t, queue.Length(), 3)
+	v, _ = queue.Peek()
+	assertEquals(t, v, 1)
+
+	assertEquals(t, queue.RequeueFront(0, 4), true)
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{0, 1, 2, 3}), true)
+
+	assertEquals(t, queue.RequeueFront(1, 0), false)
+	assertEquals(t, queue.IsEmpty(), true)
+
+	deduped := NewQueue[int]()
+	deduped.PreventDuplicates(func(a, b int) bool { return a == b })
+	deduped.Enqueue(1)
+	assertEquals(t, deduped.RequeueFront(1, 10), false)
+	assertEquals(t, deduped.Length(), 1)
+}
+
+func TestQueue_EnqueueSorted(t *testing.T) {
+	queue := NewQueue[int]()
+	less := func(a, b int) bool { return a < b }
+
+	for _, v := range []int{5, 1, 4, 1, 9, 2, 6} {
+		queue.EnqueueSorted(v, less)
+	}
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{1, 1, 2, 4, 5, 6, 9}), true)
+
+	// Equal elements keep their insertion order
+	type job struct {
+		priority int
+		name     string
+	}
+	jobs := NewQueue[job]()
+	byPriority := func(a, b job) bool { return a.priority < b.priority }
+	jobs.EnqueueSorted(job{2, "a"}, byPriority)
+	jobs.EnqueueSorted(job{1, "b"}, byPriority)
+	jobs.EnqueueSorted(job{2, "c"}, byPriority)
+	assertEquals(t, slices.Equal(jobs.DequeueAll(), []job{{1, "b"}, {2, "a"}, {2, "c"}}), true)
+
+	deduped := NewQueue[int]()
+	deduped.PreventDuplicates(func(a, b int) bool { return a == b })
+	deduped.EnqueueSorted(3, less)
+	deduped.EnqueueSorted(1, less)
+	deduped.EnqueueSorted(3, less)
+	assertEquals(t, slices.Equal(deduped.DequeueAll(), []int{1, 3}), true)
+}
+
+func TestQueue_SetEqualsFunc(t *testing.T) {
+	type ContactUser struct {
+		ID    int
+		Email string
+	}
+
+	queue := NewQueue[ContactUser]()
+	err := queue.PreventDuplicates(func(a, b ContactUser) bool {
+		return a == b
+	})
+	assertEquals(t, err, nil)
+
+	queue.Enqueue(ContactUser{ID: 1, Email: "alice@example.com"})
+	queue.Enqueue(ContactUser{ID: 1, Email: "alice@example.org"})
+	assertEquals(t, queue.Length(), 2)
+
+	queue.SetEqualsFunc(func(a, b ContactUser) bool {
+		return a.ID == b.ID
+	})
+
+	queue.Enqueue(ContactUser{ID: 1, Email: "alice@example.net"})
+	assertEquals(t, queue.Length(), 2)
+
+	queue.Enqueue(ContactUser{ID: 2, Email: "alice@example.com"})
+	assertEquals(t, queue.Length(), 3)
+}
+
+func TestQueue_Clone(t *testing.T) {
+	queue := NewQueue[int]()
+	err := queue.PreventDuplicates(func(a, b int) bool { return a == b })
+	assertEquals(t, err, nil)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+
+	clone := queue.Clone()
+	assertEquals(t, clone.Length(), 2)
+
+	// Dequeuing from the clone frees the value for the clone only
+	v, _ := clone.Dequeue()
+	assertEquals(t, v, 1)
+	clone.Enqueue(1)
+	assertEquals(t, clone.Length(), 2)
+
+	queue.Enqueue(1)
+	assertEquals(t, queue.Length(), 2)
+
+	// Both still reject their own duplicates
+	clone.Enqueue(2)
+	queue.Enqueue(2)
+	assertEquals(t, clone.Length(), 2)
+	assertEquals(t, queue.Length(), 2)
+
+	clone.Enqueue(3)
+	assertEquals(t, clone.Length(), 3)
+	assertEquals(t, queue.Length(), 2)
+
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{1, 2}), true)
+	assertEquals(t, slices.Equal(clone.DequeueAll(), []int{2, 1, 3}), true)
+}
+
+func TestQueue_Clone_Capacity(t *testing.T) {
+	queue := NewQueue[int]()
+	for i := 0; i < 5; i++ {
+		queue.Enqueue(i)
+	}
+
+	clone := queue.Clone()
+	assertEquals(t, len(clone.elements), len(queue.elements))
+	assertEquals(t, cap(clone.elements), cap(queue.elements))
+
+	queue.Dequeue()
+	clone = queue.Clone()
+	assertEquals(t, len(clone.elements), len(queue.elements))
+	assertEquals(t, cap(clone.elements), cap(queue.elements))
+}
+
+func TestQueue_SetWatermarks(t *testing.T) {
+	queue := NewQueue[int]()
+	highs, lows := 0, 0
+	queue.SetWatermarks(1, 3, func() { highs++ }, func() { lows++ })
+
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	assertEquals(t, highs, 0)
+
+	queue.Enqueue(3)
+	assertEquals(t, highs, 1)
+
+	// Staying above the high watermark does not fire again
+	queue.Enqueue(4)
+	queue.Dequeue()
+	queue.Enqueue(5)
+	assertEquals(t, highs, 1)
+	assertEquals(t, lows, 0)
+
+	queue.Dequeue()
+	queue.Dequeue()
+	assertEquals(t, lows, 0)
+	queue.Dequeue()
+	assertEquals(t, lows, 1)
+	queue.Dequeue()
+	assertEquals(t, lows, 1)
+
+	// Bulk changes fire once per crossing
+	for i := 0; i < 5; i++ {
+		queue.Enqueue(i)
+	}
+	assertEquals(t, highs, 2)
+	queue.TrimFront(10)
+	assertEquals(t, lows, 2)
+
+	queue.EnqueueSorted(1, func(a, b int) bool { return a < b })
+	queue.EnqueueSorted(2, func(a, b int) bool { return a < b })
+	queue.EnqueueSorted(0, func(a, b int) bool { return a < b })
+	assertEquals(t, highs, 3)
+	queue.DequeueMin(func(a, b int) bool { return a > b })
+	queue.TrimBack(1)
+	assertEquals(t, lows, 3)
+
+	// Clones do not inherit the callbacks
+	clone := queue.Clone()
+	clone.Enqueue(1)
+	clone.Enqueue(2)
+	assertEquals(t, highs, 3)
+}
+
+func TestQueue_DequeueErr(t *testing.T) {
+	queue := NewQueue[string]()
+
+	v, err := queue.DequeueErr()
+	assertEquals(t, errors.Is(err, ErrEmptyQueue), true)
+	assertEquals(t, v, "")
+
+	queue.Enqueue("foo")
+	v, err = queue.DequeueErr()
+	assertEquals(t, err, nil)
+	assertEquals(t, v, "foo")
+	assertEquals(t, queue.IsEmpty(), true)
+
+	_, err = queue.DequeueErr()
+	assertEquals(t, errors.Is(err, ErrEmptyQueue), true)
+}
+
+func TestQueue_DequeueIf(t *testing.T) {
+	queue := NewQueue[int]()
+	isSmall := func(v int) bool { return v < 15 }
+
+	v, ok := queue.DequeueIf(isSmall)
+	assertEquals(t, ok, false)
+	assertEquals(t, v, 0)
+
+	queue.Enqueue(10)
+	queue.Enqueue(20)
+
+	v, ok = queue.DequeueIf(isSmall)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 10)
+	assertEquals(t, queue.Length(), 1)
+
+	v, ok = queue.DequeueIf(isSmall)
+	assertEquals(t, ok, false)
+	assertEquals(t, v, 0)
+	assertEquals(t, queue.Length(), 1)
+
+	v, ok = queue.Peek()
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 20)
+}
+
+func TestQueue_DequeueMin(t *testing.T) {
+	queue := NewQueue[int]()
+	less := func(a, b int) bool { return a < b }
+
+	v, ok := queue.DequeueMin(less)
+	assertEquals(t, ok, false)
+	assertEquals(t, v, 0)
+
+	for _, v := range []int{5, 3, 8, 1, 9, 1, 4} {
+		queue.Enqueue(v)
+	}
+
+	v, ok = queue.DequeueMin(less)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 1)
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []int{5, 3, 8, 9, 1, 4}), true)
+
+	v, _ = queue.DequeueMin(less)
+	assertEquals(t, v, 1)
+	v, _ = queue.DequeueMin(less)
+	assertEquals(t, v, 3)
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []int{5, 8, 9, 4}), true)
+
+	// Minimum at the front behaves like Dequeue
+	queue = NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	v, _ = queue.DequeueMin(less)
+	assertEquals(t, v, 1)
+	v, _ = queue.DequeueMin(less)
+	assertEquals(t, v, 2)
+	assertEquals(t, queue.IsEmpty(), true)
+}
+
+func TestQueue_At(t *testing.T) {
+	queue := NewQueue[int]()
+	_, ok := queue.At(0)
+	assertEquals(t, ok, false)
+	_, ok = queue.At(-1)
+	assertEquals(t, ok, false)
+
+	queue.Enqueue(10)
+	queue.Enqueue(20)
+	queue.Enqueue(30)
+
+	v, ok := queue.At(0)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 10)
+
+	v, ok = queue.At(2)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 30)
+
+	v, ok = queue.At(-1)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 30)
+
+	v, ok = queue.At(-3)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 10)
+
+	v, ok = queue.At(-4)
+	assertEquals(t, ok, false)
+	assertEquals(t, v, 0)
+
+	_, ok = queue.At(3)
+	assertEquals(t, ok, false)
+
+	assertEquals(t, queue.Length(), 3)
+}
+
+func TestQueue_PeekOffset(t *testing.T) {
+	queue := NewQueue[string]()
+
+	v, ok := queue.PeekOffset(0)
+	assertEquals(t, ok, false)
+	assertEquals(t, v, "")
+
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+
+	v, ok = queue.PeekOffset(0)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, "a")
+
+	v, ok = queue.PeekOffset(1)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, "b")
+
+	v, ok = queue.PeekOffset(3)
+	assertEquals(t, ok, false)
+	assertEquals(t, v, "")
+
+	_, ok = queue.PeekOffset(-1)
+	assertEquals(t, ok, false)
+
+	assertEquals(t, queue.Length(), 3)
+}
+
+func TestQueue_PeekRef(t *testing.T) {
+	type Payload struct {
+		ID   int
+		Data [64]int
+	}
+
+	queue := NewQueue[Payload]()
+	ref, ok := queue.PeekRef()
+	assertEquals(t, ok, false)
+	assertEquals(t, ref, nil)
+
+	queue.Enqueue(Payload{ID: 1})
+	queue.Enqueue(Payload{ID: 2})
+
+	ref, ok = queue.PeekRef()
+	assertEquals(t, ok, true)
+	assertEquals(t, ref.ID, 1)
+
+	v, _ := queue.Peek()
+	assertEquals(t, *ref, v)
+	assertEquals(t, queue.Length(), 2)
+}
+
+// Package-level sinks stop the compiler from optimising away the copies being measured
+var (
+	peekSink    [1024]int
+	peekRefSink *[1024]int
+)
+
+func BenchmarkQueue_Peek(b *testing.B) {
+	queue := NewQueue[[1024]int]()
+	queue.Enqueue([1024]int{1})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		peekSink, _ = queue.Peek()
+	}
+}
+
+func BenchmarkQueue_PeekRef(b *testing.B) {
+	queue := NewQueue[[1024]int]()
+	queue.Enqueue([1024]int{1})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		peekRefSink, _ = queue.PeekRef()
+	}
+}
+
+func TestQueue_DequeueAll(t *testing.T) {
+	queue := NewQueue[int]()
+	assertEquals(t, len(queue.DequeueAll()), 0)
+
+	enqueued := []int{3, 1, 4, 1, 5}
+	for _, v := range enqueued {
+		queue.Enqueue(v)
+	}
+
+	assertEquals(t, slices.Equal(queue.DequeueAll(), enqueued), true)
+	assertEquals(t, queue.IsEmpty(), true)
+	assertEquals(t, queue.Length(), 0)
+}
+
+func TestQueue_SetCompactionPolicy(t *testing.T) {
+	queue := NewQueue[int]()
+	queue.SetCompactionPolicy(0.5)
+	queue.elements = make([]int, 0, 8)
+	for i := 0; i < 8; i++ {
+		queue.Enqueue(i)
+	}
+	assertEquals(t, cap(queue.elements), 8)
+
+	// Half of the backing array is dead space, which is not yet past the threshold
+	for i := 0; i < 4; i++ {
+		queue.Dequeue()
+	}
+	assertEquals(t, cap(queue.elements), 4)
+
+	queue.Dequeue()
+	assertEquals(t, cap(queue.elements), 3)
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []int{5, 6, 7}), true)
+
+	// Growing into a new backing array discards the dead space
+	queue.Dequeue()
+	queue.Enqueue(8)
+	queue.Enqueue(9)
+	assertEquals(t, queue.deadFront, 0)
+	queue.TrimFront(3)
+	assertEquals(t, cap(queue.elements), 1)
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []int{9}), true)
+}
+
+func TestQueue_SetCompactionPolicy_Disabled(t *testing.T) {
+	queue := NewQueue[int]()
+	queue.elements = make([]int, 0, 8)
+	for i := 0; i < 8; i++ {
+		queue.Enqueue(i)
+	}
+	for i := 0; i < 7; i++ {
+		queue.Dequeue()
+	}
+	assertEquals(t, cap(queue.elements), 1)
+	assertEquals(t, queue.deadFront, 7)
+
+	// Enabling the policy compacts straight away if the threshold is already exceeded
+	queue.SetCompactionPolicy(0.5)
+	assertEquals(t, queue.deadFront, 0)
+	v, _ := queue.Peek()
+	assertEquals(t, v, 7)
+}
+
+func TestQueue_TakeWhile(t *testing.T) {
+	queue := NewQueue[int]()
+	for _, v := range []int{1, 2, 5, 1, 2} {
+		queue.Enqueue(v)
+	}
+	isSmall := func(v int) bool { return v < 3 }
+
+	assertEquals(t, slices.Equal(queue.TakeWhile(isSmall), []int{1, 2}), true)
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []int{5, 1, 2}), true)
+
+	// No leading match leaves the queue unchanged
+	taken := queue.TakeWhile(isSmall)
+	assertEquals(t, taken != nil && len(taken) == 0, true)
+	assertEquals(t, queue.Length(), 3)
+
+	assertEquals(t, slices.Equal(queue.TakeWhile(func(v int) bool { return true }), []int{5, 1, 2}), true)
+	assertEquals(t, queue.IsEmpty(), true)
+	assertEquals(t, len(queue.TakeWhile(isSmall)), 0)
+}
+
+func TestQueue_DropWhile(t *testing.T) {
+	queue := NewQueue[int]()
+	for _, v := range []int{1, 2, 5, 1} {
+		queue.Enqueue(v)
+	}
+	isSmall := func(v int) bool { return v < 3 }
+
+	assertEquals(t, queue.DropWhile(isSmall), 2)
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []int{5, 1}), true)
+
+	assertEquals(t, queue.DropWhile(isSmall), 0)
+	assertEquals(t, queue.Length(), 2)
+
+	assertEquals(t, queue.DropWhile(func(v int) bool { return true }), 2)
+	assertEquals(t, queue.IsEmpty(), true)
+}
+
+func TestQueue_TrimFront(t *testing.T) {
+	queue := NewQueue[int]()
+	for i := 1; i <= 5; i++ {
+		queue.Enqueue(i)
+	}
+
+	queue.TrimFront(0)
+	assertEquals(t, queue.Length(), 5)
+
+	queue.TrimFront(2)
+	assertEquals(t, queue.Length(), 3)
+	v, _ := queue.Peek()
+	assertEquals(t, v, 3)
+
+	queue.TrimFront(10)
+	assertEquals(t, queue.IsEmpty(), true)
+
+	queue.Enqueue(6)
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{6}), true)
+}
+
+func TestQueue_TrimBack(t *testing.T) {
+	queue := NewQueue[int]()
+	for i := 1; i <= 5; i++ {
+		queue.Enqueue(i)
+	}
+
+	queue.TrimBack(-1)
+	assertEquals(t, queue.Length(), 5)
+
+	queue.TrimBack(2)
+	assertEquals(t, queue.Length(), 3)
+
+	queue.Enqueue(6)
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{1, 2, 3, 6}), true)
+
+	queue.Enqueue(7)
+	queue.TrimBack(5)
+	assertEquals(t, queue.IsEmpty(), true)
+}
+
+func TestQueue_SplitBack(t *testing.T) {
+	queue := NewQueue[int]()
+	for i := 1; i <= 5; i++ {
+		queue.Enqueue(i)
+	}
+
+	back := queue.SplitBack(2)
+	assertEquals(t, queue.Length(), 3)
+	assertEquals(t, back.Length(), 2)
+	assertEquals(t, slices.Equal(back.DequeueAll(), []int{4, 5}), true)
+
+	back = queue.SplitBack(0)
+	assertEquals(t, back.IsEmpty(), true)
+	assertEquals(t, queue.Length(), 3)
+
+	back = queue.SplitBack(10)
+	assertEquals(t, queue.IsEmpty(), true)
+	assertEquals(t, slices.Equal(back.DequeueAll(), []int{1, 2, 3}), true)
+}
+
+func TestQueue_SplitBack_PreventDuplicates(t *testing.T) {
+	queue := NewQueue[int]()
+	_ = queue.PreventDuplicates(func(a, b int) bool { return a == b })
+	for i := 1; i <= 4; i++ {
+		queue.Enqueue(i)
+	}
+
+	back := queue.SplitBack(2)
+
+	// Each half only rejects duplicates of its own elements
+	back.Enqueue(3)
+	back.Enqueue(1)
+	assertEquals(t, back.Length(), 3)
+	queue.Enqueue(1)
+	queue.Enqueue(3)
+	assertEquals(t, queue.Length(), 3)
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{1, 2, 3}), true)
+	assertEquals(t, slices.Equal(back.DequeueAll(), []int{3, 4, 1}), true)
+}
+
+func TestQueue_DequeueBatch(t *testing.T) {
+	queue := NewQueue[int]()
+	for i := 1; i <= 7; i++ {
+		queue.Enqueue(i)
+	}
+
+	var batches [][]int
+	err := queue.DequeueBatch(3, func(batch []int) error {
+		batches = append(batches, batch)
+		return nil
+	})
+	assertEquals(t, err, nil)
+	assertEquals(t, len(batches), 3)
+	assertEquals(t, slices.Equal(batches[0], []int{1, 2, 3}), true)
+	assertEquals(t, slices.Equal(batches[1], []int{4, 5, 6}), true)
+	assertEquals(t, slices.Equal(batches[2], []int{7}), true)
+	assertEquals(t, queue.IsEmpty(), true)
+
+	calls := 0
+	err = queue.DequeueBatch(3, func(batch []int) error {
+		calls++
+		return nil
+	})
+	assertEquals(t, err, nil)
+	assertEquals(t, calls, 0)
+}
+
+func TestQueue_DequeueBatch_Error(t *testing.T) {
+	queue := NewQueue[int]()
+	for i := 1; i <= 7; i++ {
+		queue.Enqueue(i)
+	}
+
+	failure := errors.New("store unavailable")
+	calls := 0
+	err := queue.DequeueBatch(2, func(batch []int) error {
+		calls++
+		if calls == 2 {
+			return failure
+		}
+		return nil
+	})
+	assertEquals(t, errors.Is(err, failure), true)
+	assertEquals(t, calls, 2)
+	// The failed batch stays at the front
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{3, 4, 5, 6, 7}), true)
+
+	// A non-positive size processes one element at a time
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	calls = 0
+	err = queue.DequeueBatch(0, func(batch []int) error {
+		calls++
+		assertEquals(t, len(batch), 1)
+		return nil
+	})
+	assertEquals(t, err, nil)
+	assertEquals(t, calls, 2)
+}
+
+func TestQueue_FlushWithRetry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	queue := NewQueue[string]()
+	queue.Enqueue("a")
+	queue.Enqueue("flaky")
+	queue.Enqueue("b")
+
+	calls := make([]string, 0)
+	failures := 0
+	err := queue.FlushWithRetry(func(v string) error {
+		calls = append(calls, v)
+		if v == "flaky" && failures < 2 {
+			failures++
+			return errFlaky
+		}
+		return nil
+	}, 3)
+
+	assertEquals(t, err, nil)
+	assertEquals(t, queue.IsEmpty(), true)
+	// Failed elements are retried after the rest of the queue
+	assertEquals(t, slices.Equal(calls, []string{"a", "flaky", "b", "flaky", "flaky"}), true)
+}
+
+func TestQueue_FlushWithRetry_GivesUp(t *testing.T) {
+	errBroken := errors.New("broken")
+
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+
+	attempts := map[int]int{}
+	err := queue.FlushWithRetry(func(v int) error {
+		attempts[v]++
+		if v%2 == 1 {
+			return errBroken
+		}
+		return nil
+	}, 3)
+
+	assertEquals(t, errors.Is(err, errBroken), true)
+	assertEquals(t, len(err.(interface{ Unwrap() []error }).Unwrap()), 2)
+	assertEquals(t, attempts[1], 3)
+	assertEquals(t, attempts[2], 1)
+	assertEquals(t, attempts[3], 3)
+	assertEquals(t, queue.IsEmpty(), true)
+
+	queue.Enqueue(1)
+	attempts = map[int]int{}
+	err = queue.FlushWithRetry(func(v int) error {
+		attempts[v]++
+		return errBroken
+	}, 0)
+	assertEquals(t, errors.Is(err, errBroken), true)
+	assertEquals(t, attempts[1], 1)
+}
+
+func TestQueue_FlushWithRetry_EnqueueDuringFlush(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	queue := NewQueue[string]()
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+
+	calls := make([]string, 0)
+	failed := false
+	err := queue.FlushWithRetry(func(v string) error {
+		calls = append(calls, v)
+		switch v {
+		case "a":
+			queue.Enqueue("a-child")
+		case "b":
+			if !failed {
+				failed = true
+				return errFlaky
+			}
+		case "a-child":
+			queue.Enqueue("a-grandchild")
+		}
+		return nil
+	}, 2)
+
+	assertEquals(t, err, nil)
+	assertEquals(t, queue.IsEmpty(), true)
+	assertEquals(t, slices.Equal(calls, []string{"a", "b", "a-child", "b", "a-grandchild"}), true)
+}
+
+func TestQueue_FlushWithRetry_Panic(t *testing.T) {
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+
+	func() {
+		defer func() {
+			recover()
+		}()
+		queue.FlushWithRetry(func(v int) error {
+			if v == 2 {
+				panic("boom")
+			}
+			return nil
+		}, 3)
+	}()
+
+	// Only the element being processed is lost
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{3}), true)
+}
+
+func TestQueue_Each(t *testing.T) {
+	queue := NewQueue[string]()
+	queue.Each(func(i int, v string) bool {
+		t.Errorf("unexpected call on empty queue")
+		return true
+	})
+
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+
+	var indices []int
+	var values []string
+	queue.Each(func(i int, v string) bool {
+		indices = append(indices, i)
+		values = append(values, v)
+		return true
+	})
+	assertEquals(t, slices.Equal(indices, []int{0, 1, 2}), true)
+	assertEquals(t, slices.Equal(values, []string{"a", "b", "c"}), true)
+
+	calls := 0
+	queue.Each(func(i int, v string) bool {
+		calls++
+		return i < 1
+	})
+	assertEquals(t, calls, 2)
+
+	assertEquals(t, queue.Length(), 3)
+	v, _ := queue.Peek()
+	assertEquals(t, v, "a")
+}
+
+func TestQueue_Contains(t *testing.T) {
+	queue := NewQueue[int]()
+	assertEquals(t, queue.Contains(func(v int) bool { return v == 10 }), false)
+	assertEquals(t, queue.IndexOf(func(v int) bool { return v == 10 }), -1)
+
+	queue.Enqueue(10)
+	queue.Enqueue(20)
+	queue.Enqueue(30)
+
+	assertEquals(t, queue.Contains(func(v int) bool { return v == 20 }), true)
+	assertEquals(t, queue.IndexOf(func(v int) bool { return v == 20 }), 1)
+	assertEquals(t, queue.Contains(func(v int) bool { return v == 40 }), false)
+	assertEquals(t, queue.IndexOf(func(v int) bool { return v == 40 }), -1)
+	assertEquals(t, queue.IndexOf(func(v int) bool { return v > 10 }), 1)
+	assertEquals(t, queue.Length(), 3)
+}
+
+func TestQueue_Reverse(t *testing.T) {
+	queue := NewQueue[int]()
+	queue.Reverse()
+	assertEquals(t, queue.IsEmpty(), true)
+
+	for i := 1; i <= 5; i++ {
+		queue.Enqueue(i)
+	}
+
+	queue.Reverse()
+	assertEquals(t, queue.Length(), 5)
+
+	for want := 5; want >= 1; want-- {
+		v, ok := queue.Dequeue()
+		assertEquals(t, ok, true)
+		assertEquals(t, v, want)
+	}
+	assertEquals(t, queue.IsEmpty(), true)
+}
+
+func TestQueue_Dedup(t *testing.T) {
+	queue := NewQueue[int]()
+	equals := func(a, b int) bool { return a == b }
+	assertEquals(t, queue.Dedup(equals), 0)
+
+	for _, v := range []int{1, 1, 2, 3, 3, 3, 1, 2, 2} {
+		queue.Enqueue(v)
+	}
+
+	assertEquals(t, queue.Dedup(equals), 4)
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []int{1, 2, 3, 1, 2}), true)
+
+	// Isolated duplicates are not collapsed
+	assertEquals(t, queue.Dedup(equals), 0)
+	assertEquals(t, queue.Length(), 5)
+
+	// The first element of each run is kept
+	words := NewQueue[string]()
+	for _, v := range []string{"Go", "GO", "go", "Rust"} {
+		words.Enqueue(v)
+	}
+	assertEquals(t, words.Dedup(strings.EqualFold), 2)
+	assertEquals(t, slices.Equal(words.DequeueAll(), []string{"Go", "Rust"}), true)
+}
+
+func TestQueue_Rewrite(t *testing.T) {
+	queue := NewQueue[int]()
+	for i := 1; i <= 6; i++ {
+		queue.Enqueue(i)
+	}
+
+	visited := 0
+	queue.Rewrite(func(v int) (int, RewriteAction) {
+		visited++
+		switch v % 3 {
+		case 0:
+			return 0, Drop
+		case 1:
+			return v * 10, Replace
+		}
+		return -1, Keep
+	})
+	assertEquals(t, visited, 6)
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{10, 2, 40, 5}), true)
+
+	queue.Rewrite(func(v int) (int, RewriteAction) {
+		t.Errorf("unexpected call on empty queue")
+		return v, Keep
+	})
+	assertEquals(t, queue.IsEmpty(), true)
+}
+
+func TestQueue_Rewrite_PreventDuplicates(t *testing.T) {
+	queue := NewQueue[string]()
+	queue.PreventDuplicates(func(a, b string) bool { return a == b })
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+
+	// "b" becomes "a", which is already queued
+	queue.Rewrite(func(v string) (string, RewriteAction) {
+		if v == "b" {
+			return "a", Replace
+		}
+		return v, Keep
+	})
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []string{"a", "c"}), true)
+
+	queue.Enqueue("c")
+	assertEquals(t, queue.Length(), 2)
+
+	// "a" becomes "c", so the later "c" is now the duplicate
+	queue.Rewrite(func(v string) (string, RewriteAction) {
+		if v == "a" {
+			return "c", Replace
+		}
+		return v, Keep
+	})
+	assertEquals(t, slices.Equal(queue.Clone().DequeueAll(), []string{"c"}), true)
+}
+
+func TestMinMax(t *testing.T) {
+	queue := NewQueue[int]()
+
+	v, ok := Min(queue)
+	assertEquals(t, ok, false)
+	assertEquals(t, v, 0)
+	v, ok = Max(queue)
+	assertEquals(t, ok, false)
+	assertEquals(t, v, 0)
+
+	for _, v := range []int{4, -2, 9, 3, 9} {
+		queue.Enqueue(v)
+	}
+
+	v, ok = Min(queue)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, -2)
+	v, ok = Max(queue)
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 9)
+
+	assertEquals(t, slices.Equal(queue.DequeueAll(), []int{4, -2, 9, 3, 9}), true)
+
+	words := NewQueue[string]()
+	words.Enqueue("pear")
+	words.Enqueue("apple")
+	s, _ := Min(words)
+	assertEquals(t, s, "apple")
+}
+
+func TestGroupBy(t *testing.T) {
+	type Message struct {
+		Kind string
+		ID   int
+	}
+
+	queue := NewQueue[Message]()
+	queue.Enqueue(Message{Kind: "email", ID: 1})
+	queue.Enqueue(Message{Kind: "sms", ID: 2})
+	queue.Enqueue(Message{Kind: "email", ID: 3})
+	queue.Enqueue(Message{Kind: "push", ID: 4})
+	queue.Enqueue(Message{Kind: "sms", ID: 5})
+	queue.Enqueue(Message{Kind: "email", ID: 6})
+
+	groups := GroupBy(queue, func(m Message) string { return m.Kind })
+
+	assertEquals(t, queue.IsEmpty(), true)
+	assertEquals(t, len(groups), 3)
+
+	ids := func(q *Queue[Message]) []int {
+		result := make([]int, 0)
+		for _, m := range q.DequeueAll() {
+			result = append(result, m.ID)
+		}
+		return result
+	}
+	assertEquals(t, slices.Equal(ids(groups["email"]), []int{1, 3, 6}), true)
+	assertEquals(t, slices.Equal(ids(groups["sms"]), []int{2, 5}), true)
+	assertEquals(t, slices.Equal(ids(groups["push"]), []int{4}), true)
+
+	assertEquals(t, len(GroupBy(queue, func(m Message) string { return m.Kind })), 0)
+}
+
 func assertEquals[V comparable](t *testing.T, got, want V) {
 	t.Helper()
 	if got != want {
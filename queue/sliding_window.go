@@ -0,0 +1,38 @@
+package queue
+
+import "cmp"
+
+// SlidingWindowMax returns the maximum of every window of k consecutive elements in nums,
+// in window order. Returns an empty slice if k is less than 1 or greater than len(nums).
+// Runs in O(n) by keeping a monotonic deque of indices whose values decrease from front to back.
+//
+// Example:
+//
+//	maxima := SlidingWindowMax([]int{1, 3, -1, -3, 5, 3, 6, 7}, 3)
+//	fmt.Println(maxima) // Output: [3 3 5 5 6 7]
+func SlidingWindowMax[T cmp.Ordered](nums []T, k int) []T {
+	if k < 1 || k > len(nums) {
+		return []T{}
+	}
+
+	result := make([]T, 0, len(nums)-k+1)
+	window := make([]int, 0, k)
+	for i, num := range nums {
+		// drop the front index once it slides out of the window
+		if len(window) > 0 && window[0] <= i-k {
+			window = window[1:]
+		}
+
+		// smaller values at the back can never be a maximum while num is in the window
+		for len(window) > 0 && nums[window[len(window)-1]] <= num {
+			window = window[:len(window)-1]
+		}
+		window = append(window, i)
+
+		if i >= k-1 {
+			result = append(result, nums[window[0]])
+		}
+	}
+
+	return result
+}
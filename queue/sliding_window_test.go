@@ -0,0 +1,20 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSlidingWindowMax(t *testing.T) {
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+
+	assertEquals(t, slices.Equal(SlidingWindowMax(nums, 3), []int{3, 3, 5, 5, 6, 7}), true)
+	assertEquals(t, slices.Equal(SlidingWindowMax(nums, 1), nums), true)
+	assertEquals(t, slices.Equal(SlidingWindowMax(nums, len(nums)), []int{7}), true)
+	assertEquals(t, slices.Equal(SlidingWindowMax([]int{9, 8, 7, 6}, 2), []int{9, 8, 7}), true)
+	assertEquals(t, slices.Equal(SlidingWindowMax([]string{"b", "a", "c"}, 2), []string{"b", "c"}), true)
+
+	assertEquals(t, len(SlidingWindowMax(nums, 0)), 0)
+	assertEquals(t, len(SlidingWindowMax(nums, len(nums)+1)), 0)
+	assertEquals(t, len(SlidingWindowMax([]int{}, 1)), 0)
+}
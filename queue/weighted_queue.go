@@ -0,0 +1,120 @@
+package queue
+
+// WeightedQueue multiplexes several logical streams, each a FIFO queue, and dequeues across
+// them in proportion to per-stream weights using smooth weighted round-robin.
+// Unlike strict priorities, a stream with weight 2 is served twice as often as a stream with
+// weight 1, but never starves it. Empty streams are skipped and do not accumulate credit.
+// The zero value is not usable; use NewWeightedQueue to create a new WeightedQueue.
+type WeightedQueue[T any] struct {
+	streams map[string]*Queue[T]
+	weights map[string]int
+	credit  map[string]int
+	order   []string
+	length  int
+}
+
+// NewWeightedQueue creates and returns an empty weighted queue using the given per-stream weights.
+// Streams without a configured weight, or with a weight below 1, use a weight of 1.
+//
+// Example:
+//
+//	q := NewWeightedQueue[string](map[string]int{"interactive": 2, "batch": 1})
+//	q.Enqueue("render", "interactive")
+func NewWeightedQueue[T any](weights map[string]int) *WeightedQueue[T] {
+	w := &WeightedQueue[T]{
+		streams: make(map[string]*Queue[T]),
+		weights: make(map[string]int, len(weights)),
+		credit:  make(map[string]int),
+	}
+	for streamID, weight := range weights {
+		w.weights[streamID] = max(weight, 1)
+	}
+
+	return w
+}
+
+// Enqueue adds an item to the back of the given stream.
+//
+// Example:
+//
+//	q := NewWeightedQueue[int](map[string]int{"a": 2, "b": 1})
+//	q.Enqueue(1, "a") // stream "a" now contains: [1]
+//	q.Enqueue(2, "b") // stream "b" now contains: [2]
+func (w *WeightedQueue[T]) Enqueue(item T, streamID string) {
+	stream, ok := w.streams[streamID]
+	if !ok {
+		stream = NewQueue[T]()
+		w.streams[streamID] = stream
+		w.order = append(w.order, streamID)
+		if _, configured := w.weights[streamID]; !configured {
+			w.weights[streamID] = 1
+		}
+	}
+
+	stream.Enqueue(item)
+	w.length++
+}
+
+// Dequeue removes and returns the front item of the stream chosen by weighted round-robin.
+// Returns the item and true if successful, or zero value and false if every stream is empty.
+//
+// Example:
+//
+//	q := NewWeightedQueue[string](map[string]int{"a": 2, "b": 1})
+//	q.Enqueue("a1", "a")
+//	q.Enqueue("a2", "a")
+//	q.Enqueue("b1", "b")
+//	val, ok := q.Dequeue() // val = "a1", ok = true
+//	val, ok = q.Dequeue()  // val = "b1", ok = true
+//	val, ok = q.Dequeue()  // val = "a2", ok = true
+func (w *WeightedQueue[T]) Dequeue() (T, bool) {
+	if w.IsEmpty() {
+		var empty T
+		return empty, false
+	}
+
+	selected := ""
+	total := 0
+	for _, streamID := range w.order {
+		if w.streams[streamID].IsEmpty() {
+			continue
+		}
+		w.credit[streamID] += w.weights[streamID]
+		total += w.weights[streamID]
+		if selected == "" || w.credit[streamID] > w.credit[selected] {
+			selected = streamID
+		}
+	}
+	w.credit[selected] -= total
+
+	item, _ := w.streams[selected].Dequeue()
+	w.length--
+	if w.streams[selected].IsEmpty() {
+		// Drained streams start afresh so they don't carry credit or debt into their next burst
+		w.credit[selected] = 0
+	}
+
+	return item, true
+}
+
+// Length returns the number of items currently queued across all streams.
+//
+// Example:
+//
+//	q := NewWeightedQueue[int](nil)
+//	q.Enqueue(1, "a")
+//	q.Enqueue(2, "b")
+//	fmt.Println(q.Length()) // Output: 2
+func (w *WeightedQueue[T]) Length() int {
+	return w.length
+}
+
+// IsEmpty returns true if every stream is empty, false otherwise.
+//
+// Example:
+//
+//	q := NewWeightedQueue[int](nil)
+//	fmt.Println(q.IsEmpty()) // Output: true
+func (w *WeightedQueue[T]) IsEmpty() bool {
+	return w.length == 0
+}
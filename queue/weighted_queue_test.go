@@ -0,0 +1,53 @@
+package queue
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWeightedQueue(t *testing.T) {
+	queue := NewWeightedQueue[string](map[string]int{"a": 2, "b": 1})
+	assertEquals(t, queue.IsEmpty(), true)
+
+	v, ok := queue.Dequeue()
+	assertEquals(t, ok, false)
+	assertEquals(t, v, "")
+
+	queue.Enqueue("a1", "a")
+	queue.Enqueue("a2", "a")
+	queue.Enqueue("a3", "a")
+	queue.Enqueue("b1", "b")
+	queue.Enqueue("c1", "c")
+	assertEquals(t, queue.Length(), 5)
+	assertEquals(t, queue.IsEmpty(), false)
+
+	// Order within each stream is FIFO
+	seen := map[string][]string{}
+	for !queue.IsEmpty() {
+		v, ok = queue.Dequeue()
+		assertEquals(t, ok, true)
+		seen[v[:1]] = append(seen[v[:1]], v)
+	}
+	assertEquals(t, fmt.Sprint(seen["a"]), "[a1 a2 a3]")
+	assertEquals(t, fmt.Sprint(seen["b"]), "[b1]")
+	assertEquals(t, fmt.Sprint(seen["c"]), "[c1]")
+	assertEquals(t, queue.Length(), 0)
+}
+
+func TestWeightedQueue_Ratio(t *testing.T) {
+	queue := NewWeightedQueue[string](map[string]int{"a": 2, "b": 1})
+	for i := 0; i < 300; i++ {
+		queue.Enqueue("a", "a")
+		queue.Enqueue("b", "b")
+	}
+
+	counts := map[string]int{}
+	for i := 0; i < 300; i++ {
+		v, _ := queue.Dequeue()
+		counts[v]++
+	}
+
+	assertEquals(t, counts["a"], 200)
+	assertEquals(t, counts["b"], 100)
+	assertEquals(t, queue.Length(), 300)
+}
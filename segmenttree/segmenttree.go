@@ -0,0 +1,120 @@
+package segmenttree
+
+import "fmt"
+
+// SegmentTree answers aggregate queries over ranges of a slice, such as sums, minimums or
+// maximums, while supporting point updates. Both operations run in O(log n).
+// The combine function must be associative; it does not need an identity element.
+// The zero value is not usable; use NewSegmentTree to create a new SegmentTree.
+type SegmentTree[T any] struct {
+	nodes   []T
+	size    int
+	combine func(a, b T) T
+}
+
+// NewSegmentTree builds a segment tree over a copy of values, aggregating with combine.
+//
+// Example:
+//
+//	tree := NewSegmentTree([]int{5, 2, 8}, func(a, b int) int { return a + b })
+//	fmt.Println(tree.Query(0, 2)) // Output: 15
+func NewSegmentTree[T any](values []T, combine func(a, b T) T) *SegmentTree[T] {
+	t := &SegmentTree[T]{
+		nodes:   make([]T, 4*max(len(values), 1)),
+		size:    len(values),
+		combine: combine,
+	}
+	if t.size > 0 {
+		t.build(values, 1, 0, t.size-1)
+	}
+
+	return t
+}
+
+// Len returns the number of values in the tree.
+//
+// Example:
+//
+//	tree := NewSegmentTree([]int{5, 2, 8}, func(a, b int) int { return a + b })
+//	fmt.Println(tree.Len()) // Output: 3
+func (t *SegmentTree[T]) Len() int {
+	return t.size
+}
+
+// Query returns the combination of the values at indices lo through hi, inclusive.
+// Panics if the range is empty or out of bounds.
+//
+// Example:
+//
+//	tree := NewSegmentTree([]int{5, 2, 8, 1}, func(a, b int) int { return min(a, b) })
+//	fmt.Println(tree.Query(0, 2)) // Output: 2
+func (t *SegmentTree[T]) Query(lo, hi int) T {
+	if lo < 0 || hi >= t.size || lo > hi {
+		panic(fmt.Sprintf("segmenttree: query range [%d, %d] out of bounds for length %d", lo, hi, t.size))
+	}
+
+	return t.query(1, 0, t.size-1, lo, hi)
+}
+
+// Update replaces the value at index i.
+// Panics if i is out of bounds.
+//
+// Example:
+//
+//	tree := NewSegmentTree([]int{5, 2, 8}, func(a, b int) int { return a + b })
+//	tree.Update(1, 10)
+//	fmt.Println(tree.Query(0, 2)) // Output: 23
+func (t *SegmentTree[T]) Update(i int, value T) {
+	if i < 0 || i >= t.size {
+		panic(fmt.Sprintf("segmenttree: index %d out of bounds for length %d", i, t.size))
+	}
+
+	t.update(1, 0, t.size-1, i, value)
+}
+
+// build fills node, which covers values[lo..hi], and its descendants.
+func (t *SegmentTree[T]) build(values []T, node, lo, hi int) {
+	if lo == hi {
+		t.nodes[node] = values[lo]
+		return
+	}
+
+	mid := (lo + hi) / 2
+	t.build(values, 2*node, lo, mid)
+	t.build(values, 2*node+1, mid+1, hi)
+	t.nodes[node] = t.combine(t.nodes[2*node], t.nodes[2*node+1])
+}
+
+// query combines the part of [qlo, qhi] that falls within node's range [lo, hi].
+// The caller guarantees the two ranges overlap.
+func (t *SegmentTree[T]) query(node, lo, hi, qlo, qhi int) T {
+	if qlo <= lo && hi <= qhi {
+		return t.nodes[node]
+	}
+
+	mid := (lo + hi) / 2
+	if qhi <= mid {
+		return t.query(2*node, lo, mid, qlo, qhi)
+	}
+	if qlo > mid {
+		return t.query(2*node+1, mid+1, hi, qlo, qhi)
+	}
+
+	return t.combine(t.query(2*node, lo, mid, qlo, qhi), t.query(2*node+1, mid+1, hi, qlo, qhi))
+}
+
+// update sets index i within node's range [lo, hi] and recomputes the aggregates above it.
+func (t *SegmentTree[T]) update(node, lo, hi, i int, value T) {
+	if lo == hi {
+		t.nodes[node] = value
+		return
+	}
+
+	mid := (lo + hi) / 2
+	if i <= mid {
+		t.update(2*node, lo, mid, i, value)
+	} else {
+		t.update(2*node+1, mid+1, hi, i, value)
+	}
+	t.nodes[node] = t.combine(t.nodes[2*node], t.nodes[2*node+1])
+}
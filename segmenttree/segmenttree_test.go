@@ -0,0 +1,88 @@
+package segmenttree
+
+import (
+	"testing"
+)
+
+func sum(a, b int) int { return a + b }
+
+func TestSegmentTree_Sum(t *testing.T) {
+	values := []int{5, 2, 8, 1, 9, 3}
+	tree := NewSegmentTree(values, sum)
+	assertEquals(t, tree.Len(), 6)
+
+	assertEquals(t, tree.Query(0, 5), 28)
+	assertEquals(t, tree.Query(1, 3), 11)
+	assertEquals(t, tree.Query(4, 4), 9)
+
+	tree.Update(2, 0)
+	assertEquals(t, tree.Query(0, 5), 20)
+	assertEquals(t, tree.Query(1, 3), 3)
+	assertEquals(t, tree.Query(2, 2), 0)
+
+	tree.Update(5, 10)
+	assertEquals(t, tree.Query(3, 5), 20)
+	assertEquals(t, tree.Query(5, 5), 10)
+
+	// The tree works on a copy of the input
+	values[0] = 100
+	assertEquals(t, tree.Query(0, 0), 5)
+}
+
+func TestSegmentTree_Min(t *testing.T) {
+	values := []int{5, 2, 8, 1, 9, 3}
+	tree := NewSegmentTree(values, func(a, b int) int { return min(a, b) })
+
+	assertEquals(t, tree.Query(0, 5), 1)
+	assertEquals(t, tree.Query(0, 2), 2)
+	assertEquals(t, tree.Query(4, 5), 3)
+
+	tree.Update(3, 7)
+	assertEquals(t, tree.Query(0, 5), 2)
+	assertEquals(t, tree.Query(2, 4), 7)
+
+	tree.Update(1, 6)
+	assertEquals(t, tree.Query(0, 2), 5)
+	assertEquals(t, tree.Query(1, 1), 6)
+
+	// Compare every range against a brute-force minimum
+	for lo := 0; lo < len(values); lo++ {
+		for hi := lo; hi < len(values); hi++ {
+			want := tree.Query(lo, lo)
+			for i := lo + 1; i <= hi; i++ {
+				want = min(want, tree.Query(i, i))
+			}
+			assertEquals(t, tree.Query(lo, hi), want)
+		}
+	}
+}
+
+func TestSegmentTree_OutOfBounds(t *testing.T) {
+	tree := NewSegmentTree([]int{1, 2, 3}, sum)
+
+	assertPanics(t, func() { tree.Query(-1, 1) })
+	assertPanics(t, func() { tree.Query(0, 3) })
+	assertPanics(t, func() { tree.Query(2, 1) })
+	assertPanics(t, func() { tree.Update(3, 1) })
+
+	empty := NewSegmentTree([]int{}, sum)
+	assertEquals(t, empty.Len(), 0)
+	assertPanics(t, func() { empty.Query(0, 0) })
+}
+
+func assertPanics(t *testing.T, fn func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("failed to panic")
+		}
+	}()
+	fn()
+}
+
+func assertEquals[V comparable](t *testing.T, got, want V) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,114 @@
+package set
+
+import "sync"
+
+// AtomicSet represents a thread-safe collection of unique elements backed by a sync.Map instead
+// of a mutex. Reads of existing members do not contend with each other, so it can outperform Set
+// for read-heavy workloads whose members are added once and read many times, such as presence
+// flags. Size and Members walk every entry, so prefer Set when those are called often.
+// The zero value is an empty AtomicSet ready to use, and an AtomicSet must not be copied after first use.
+type AtomicSet[T comparable] struct {
+	members sync.Map
+}
+
+// NewAtomicSet creates and initializes a new empty AtomicSet.
+//
+// Example:
+//
+//	s := NewAtomicSet[string]()
+//	s.Add("foo")
+func NewAtomicSet[T comparable]() *AtomicSet[T] {
+	return &AtomicSet[T]{}
+}
+
+// Add inserts an element into the AtomicSet.
+// If the element already exists, the AtomicSet remains unchanged.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewAtomicSet[int]()
+//	s.Add(1) // Set now contains 1
+func (s *AtomicSet[T]) Add(member T) {
+	// LoadOrStore avoids a write when the member is already present
+	s.members.LoadOrStore(member, struct{}{})
+}
+
+// AddIfAbsent inserts an element into the AtomicSet and reports whether it was added.
+// Returns true if the element was not present before, false otherwise. When several goroutines
+// add the same element concurrently, exactly one of them sees true.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewAtomicSet[int]()
+//	fmt.Println(s.AddIfAbsent(1)) // Output: true
+//	fmt.Println(s.AddIfAbsent(1)) // Output: false
+func (s *AtomicSet[T]) AddIfAbsent(member T) bool {
+	_, loaded := s.members.LoadOrStore(member, struct{}{})
+	return !loaded
+}
+
+// Remove deletes an element from the AtomicSet.
+// If the element doesn't exist, the AtomicSet remains unchanged.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewAtomicSet[int]()
+//	s.Add(1)
+//	s.Remove(1) // Set is now empty
+func (s *AtomicSet[T]) Remove(member T) {
+	s.members.Delete(member)
+}
+
+// Contains returns true if the element exists in the AtomicSet, false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewAtomicSet[string]()
+//	s.Add("foo")
+//	fmt.Println(s.Contains("foo")) // Output: true
+func (s *AtomicSet[T]) Contains(member T) bool {
+	_, exists := s.members.Load(member)
+	return exists
+}
+
+// Size returns the number of elements in the AtomicSet. It walks every entry, so it is O(n), and
+// under concurrent modification the result may not correspond to any single point in time.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewAtomicSet[int]()
+//	s.Add(1)
+//	fmt.Println(s.Size()) // Output: 1
+func (s *AtomicSet[T]) Size() int {
+	size := 0
+	s.members.Range(func(_, _ any) bool {
+		size++
+		return true
+	})
+	return size
+}
+
+// Members returns a slice containing all elements in the AtomicSet.
+// The order of elements is not guaranteed, and under concurrent modification the result may not
+// correspond to any single point in time.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewAtomicSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	fmt.Println(s.Members()) // Output: [1 2] (order not guaranteed)
+func (s *AtomicSet[T]) Members() []T {
+	members := make([]T, 0)
+	s.members.Range(func(member, _ any) bool {
+		members = append(members, member.(T))
+		return true
+	})
+	return members
+}
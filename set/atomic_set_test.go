@@ -0,0 +1,93 @@
+package set
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAtomicSet_AddRemoveSize(t *testing.T) {
+	set := NewAtomicSet[int]()
+	assertEquals(t, set.Size(), 0)
+
+	set.Add(1)
+	set.Add(2)
+	set.Add(2)
+	assertEquals(t, set.Size(), 2)
+	assertEquals(t, set.Contains(1), true)
+	assertEquals(t, set.Contains(3), false)
+
+	set.Remove(1)
+	set.Remove(3)
+	assertEquals(t, set.Size(), 1)
+	assertEquals(t, set.Contains(1), false)
+
+	members := set.Members()
+	assertEquals(t, slices.Equal(members, []int{2}), true)
+
+	// The zero value is usable
+	var zero AtomicSet[string]
+	zero.Add("foo")
+	assertEquals(t, zero.Contains("foo"), true)
+}
+
+func TestAtomicSet_AddIfAbsent(t *testing.T) {
+	set := NewAtomicSet[string]()
+	assertEquals(t, set.AddIfAbsent("foo"), true)
+	assertEquals(t, set.AddIfAbsent("foo"), false)
+	set.Remove("foo")
+	assertEquals(t, set.AddIfAbsent("foo"), true)
+}
+
+func TestAtomicSet_Concurrent(t *testing.T) {
+	set := NewAtomicSet[int]()
+	var added atomic.Int64
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if set.AddIfAbsent(i) {
+					added.Add(1)
+				}
+				set.Contains(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assertEquals(t, added.Load(), int64(100))
+	assertEquals(t, set.Size(), 100)
+}
+
+// benchmarkReadHeavy runs 99 lookups per add across parallel goroutines.
+func benchmarkReadHeavy(b *testing.B, add func(int), contains func(int) bool) {
+	for i := 0; i < 1000; i++ {
+		add(i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%100 == 0 {
+				add(i % 1000)
+			} else {
+				contains(i % 1000)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkAtomicSet_ReadHeavy(b *testing.B) {
+	set := NewAtomicSet[int]()
+	benchmarkReadHeavy(b, set.Add, set.Contains)
+}
+
+func BenchmarkSet_ReadHeavy(b *testing.B) {
+	set := NewSet[int]()
+	benchmarkReadHeavy(b, set.Add, set.Contains)
+}
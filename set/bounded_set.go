@@ -0,0 +1,170 @@
+package set
+
+import "sync"
+
+// BoundedSet represents a thread-safe collection of unique elements with a hard size limit.
+// Once the limit is reached, new distinct elements are rejected until space is freed.
+//
+// BoundedSet deliberately does not offer Union or Difference, as their results could exceed
+// the limit. Use Members to copy the contents into a regular Set when set algebra is needed.
+// Intersect is provided because its result can never be larger than the receiver.
+//
+// The zero value is not usable; use NewBoundedSet to create a new BoundedSet.
+type BoundedSet[T comparable] struct {
+	members map[T]struct{}
+	max     int
+	mu      sync.RWMutex
+}
+
+// NewBoundedSet creates and initializes a new empty BoundedSet that holds at most max elements.
+//
+// Example:
+//
+//	s := NewBoundedSet[string](100)
+//	s.Add("foo")
+func NewBoundedSet[T comparable](max int) *BoundedSet[T] {
+	return &BoundedSet[T]{
+		members: make(map[T]struct{}),
+		max:     max,
+	}
+}
+
+// Add inserts an element into the BoundedSet.
+// Returns true if the element is present after the call, or false if it was rejected because
+// the set is full. Re-adding an existing element always succeeds, even when the set is full.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewBoundedSet[int](1)
+//	fmt.Println(s.Add(1)) // Output: true
+//	fmt.Println(s.Add(2)) // Output: false (set is full)
+//	fmt.Println(s.Add(1)) // Output: true (already present)
+func (s *BoundedSet[T]) Add(member T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.members[member]; exists {
+		return true
+	}
+	if len(s.members) >= s.max {
+		return false
+	}
+	s.members[member] = struct{}{}
+	return true
+}
+
+// Remove deletes an element from the BoundedSet, freeing space for a new element.
+// If the element doesn't exist, the BoundedSet remains unchanged.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewBoundedSet[int](1)
+//	s.Add(1)
+//	s.Remove(1)
+//	fmt.Println(s.Add(2)) // Output: true
+func (s *BoundedSet[T]) Remove(member T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.members, member)
+}
+
+// Contains returns true if the element exists in the BoundedSet, false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewBoundedSet[string](10)
+//	s.Add("foo")
+//	fmt.Println(s.Contains("foo")) // Output: true
+func (s *BoundedSet[T]) Contains(member T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, exists := s.members[member]
+	return exists
+}
+
+// Size returns the number of elements in the BoundedSet.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewBoundedSet[int](10)
+//	s.Add(1)
+//	fmt.Println(s.Size()) // Output: 1
+func (s *BoundedSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.members)
+}
+
+// Cap returns the maximum number of elements the BoundedSet can hold.
+//
+// Example:
+//
+//	s := NewBoundedSet[int](10)
+//	fmt.Println(s.Cap()) // Output: 10
+func (s *BoundedSet[T]) Cap() int {
+	return s.max
+}
+
+// IsFull returns true if the BoundedSet has reached its maximum size, false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewBoundedSet[int](1)
+//	s.Add(1)
+//	fmt.Println(s.IsFull()) // Output: true
+func (s *BoundedSet[T]) IsFull() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.members) >= s.max
+}
+
+// Members returns a slice containing all elements in the BoundedSet.
+// The order of elements is not guaranteed to be stable between calls.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewBoundedSet[int](10)
+//	s.Add(1)
+//	s.Add(2)
+//	fmt.Println(s.Members()) // Output: [1 2] (order not guaranteed)
+func (s *BoundedSet[T]) Members() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	members := make([]T, 0, len(s.members))
+	for member := range s.members {
+		members = append(members, member)
+	}
+	return members
+}
+
+// Intersect returns a new BoundedSet containing elements that are present in both sets.
+// The result has the same limit as the receiver, which it can never exceed.
+// This operation is thread-safe and does not modify the original sets.
+//
+// Example:
+//
+//	s1 := NewBoundedSet[int](10)
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewBoundedSet[int](10)
+//	s2.Add(2)
+//	result := s1.Intersect(s2)
+//	fmt.Println(result.Members()) // Output: [2]
+func (s *BoundedSet[T]) Intersect(other *BoundedSet[T]) *BoundedSet[T] {
+	result := NewBoundedSet[T](s.max)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+	for member := range s.members {
+		if _, exists := other.members[member]; exists {
+			result.members[member] = struct{}{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBoundedSet_Add(t *testing.T) {
+	set := NewBoundedSet[int](3)
+	assertEquals(t, set.Cap(), 3)
+	assertEquals(t, set.IsFull(), false)
+
+	assertEquals(t, set.Add(1), true)
+	assertEquals(t, set.Add(2), true)
+	assertEquals(t, set.Add(3), true)
+	assertEquals(t, set.Size(), 3)
+	assertEquals(t, set.IsFull(), true)
+
+	assertEquals(t, set.Add(4), false)
+	assertEquals(t, set.Size(), 3)
+	assertEquals(t, set.Contains(4), false)
+
+	// Existing members can always be re-added
+	assertEquals(t, set.Add(1), true)
+	assertEquals(t, set.Add(3), true)
+	assertEquals(t, set.Size(), 3)
+
+	set.Remove(2)
+	assertEquals(t, set.IsFull(), false)
+	assertEquals(t, set.Add(4), true)
+	assertEquals(t, set.Contains(4), true)
+	assertEquals(t, set.Size(), 3)
+}
+
+func TestBoundedSet_Intersect(t *testing.T) {
+	s1 := NewBoundedSet[int](3)
+	s1.Add(1)
+	s1.Add(2)
+	s1.Add(3)
+
+	s2 := NewBoundedSet[int](5)
+	s2.Add(2)
+	s2.Add(3)
+	s2.Add(4)
+
+	result := s1.Intersect(s2)
+	members := result.Members()
+
+	assertEquals(t, result.Cap(), 3)
+	assertEquals(t, len(members), 2)
+	assertEquals(t, slices.Contains(members, 2), true)
+	assertEquals(t, slices.Contains(members, 3), true)
+}
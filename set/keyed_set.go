@@ -0,0 +1,122 @@
+package set
+
+import "sync"
+
+// KeyedSet represents a thread-safe collection of values that are unique by a derived key.
+// It brings Set semantics to types that are not comparable, or whose equality should ignore
+// some fields: two values are the same member if key returns the same result for both.
+// The zero value is not usable; use NewKeyedSet to create a new KeyedSet.
+type KeyedSet[T any, K comparable] struct {
+	members map[K]T
+	key     func(T) K
+	mu      sync.RWMutex
+}
+
+// NewKeyedSet creates and initializes a new empty KeyedSet that identifies values by key.
+//
+// Example:
+//
+//	s := NewKeyedSet(func(u User) string { return u.Email })
+//	s.Add(User{Email: "alice@example.com"})
+func NewKeyedSet[T any, K comparable](key func(T) K) *KeyedSet[T, K] {
+	return &KeyedSet[T, K]{
+		members: make(map[K]T),
+		key:     key,
+	}
+}
+
+// Add inserts a value into the KeyedSet.
+// If a value with the same key already exists, it is replaced by the new value, so the
+// KeyedSet always holds the most recently added value for each key.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewKeyedSet(func(u User) string { return u.Email })
+//	s.Add(User{Email: "alice@example.com", Name: "Alice"})
+//	s.Add(User{Email: "alice@example.com", Name: "Alice Smith"}) // replaces the first value
+func (s *KeyedSet[T, K]) Add(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.members[s.key(value)] = value
+}
+
+// Remove deletes the value with the given key from the KeyedSet.
+// If no value has that key, the KeyedSet remains unchanged.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewKeyedSet(func(u User) string { return u.Email })
+//	s.Add(User{Email: "alice@example.com"})
+//	s.Remove("alice@example.com") // Set is now empty
+func (s *KeyedSet[T, K]) Remove(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.members, key)
+}
+
+// Contains returns true if a value with the given key exists in the KeyedSet, false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewKeyedSet(func(u User) string { return u.Email })
+//	s.Add(User{Email: "alice@example.com"})
+//	fmt.Println(s.Contains("alice@example.com")) // Output: true
+func (s *KeyedSet[T, K]) Contains(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, exists := s.members[key]
+	return exists
+}
+
+// Get returns the value stored under the given key.
+// Returns the value and true if it exists, or zero value and false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewKeyedSet(func(u User) string { return u.Email })
+//	s.Add(User{Email: "alice@example.com", Name: "Alice"})
+//	u, ok := s.Get("alice@example.com") // u.Name = "Alice", ok = true
+func (s *KeyedSet[T, K]) Get(key K) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, exists := s.members[key]
+	return value, exists
+}
+
+// Size returns the number of values in the KeyedSet.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewKeyedSet(func(u User) string { return u.Email })
+//	s.Add(User{Email: "alice@example.com"})
+//	fmt.Println(s.Size()) // Output: 1
+func (s *KeyedSet[T, K]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.members)
+}
+
+// Members returns a slice containing all values in the KeyedSet.
+// The order of values is not guaranteed to be stable between calls.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewKeyedSet(func(u User) string { return u.Email })
+//	s.Add(User{Email: "alice@example.com"})
+//	s.Add(User{Email: "bob@example.com"})
+//	fmt.Println(len(s.Members())) // Output: 2
+func (s *KeyedSet[T, K]) Members() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	members := make([]T, 0, len(s.members))
+	for _, value := range s.members {
+		members = append(members, value)
+	}
+	return members
+}
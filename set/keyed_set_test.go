@@ -0,0 +1,48 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+type keyedUser struct {
+	Email string
+	Name  string
+	Tags  []string // makes the struct non-comparable
+}
+
+func TestKeyedSet(t *testing.T) {
+	set := NewKeyedSet(func(u keyedUser) string { return u.Email })
+	assertEquals(t, set.Size(), 0)
+
+	_, ok := set.Get("alice@example.com")
+	assertEquals(t, ok, false)
+
+	set.Add(keyedUser{Email: "alice@example.com", Name: "Alice"})
+	set.Add(keyedUser{Email: "bob@example.com", Name: "Bob", Tags: []string{"admin"}})
+	assertEquals(t, set.Size(), 2)
+	assertEquals(t, set.Contains("alice@example.com"), true)
+	assertEquals(t, set.Contains("carol@example.com"), false)
+
+	user, ok := set.Get("bob@example.com")
+	assertEquals(t, ok, true)
+	assertEquals(t, user.Name, "Bob")
+	assertEquals(t, slices.Equal(user.Tags, []string{"admin"}), true)
+
+	// A second value with the same key replaces the first
+	set.Add(keyedUser{Email: "alice@example.com", Name: "Alice Smith"})
+	assertEquals(t, set.Size(), 2)
+	user, _ = set.Get("alice@example.com")
+	assertEquals(t, user.Name, "Alice Smith")
+
+	names := make([]string, 0)
+	for _, member := range set.Members() {
+		names = append(names, member.Name)
+	}
+	slices.Sort(names)
+	assertEquals(t, slices.Equal(names, []string{"Alice Smith", "Bob"}), true)
+
+	set.Remove("bob@example.com")
+	assertEquals(t, set.Size(), 1)
+	assertEquals(t, set.Contains("bob@example.com"), false)
+}
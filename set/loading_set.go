@@ -0,0 +1,128 @@
+package set
+
+import "sync"
+
+// LoadingSet represents a thread-safe collection of unique elements that is populated lazily.
+// When Contains is asked about an element it does not hold, it consults a loader function and
+// remembers the element if the loader reports it as a member.
+// Negative results are not cached, so the loader is asked again on the next miss.
+// The zero value is not usable; use NewLoadingSet to create a new LoadingSet.
+type LoadingSet[T comparable] struct {
+	members  map[T]struct{}
+	loader   func(T) bool
+	inflight map[T]*loadCall
+	mu       sync.RWMutex
+}
+
+// loadCall tracks a loader call in progress, so concurrent misses for the same element can wait
+// for its result instead of calling the loader again.
+type loadCall struct {
+	done  chan struct{}
+	found bool
+}
+
+// NewLoadingSet creates and initializes a new empty LoadingSet backed by loader.
+//
+// Example:
+//
+//	s := NewLoadingSet(func(user string) bool {
+//		return db.UserExists(user)
+//	})
+func NewLoadingSet[T comparable](loader func(T) bool) *LoadingSet[T] {
+	return &LoadingSet[T]{
+		members:  make(map[T]struct{}),
+		loader:   loader,
+		inflight: make(map[T]*loadCall),
+	}
+}
+
+// Contains returns true if the element is held by the LoadingSet or the loader reports it as a member,
+// in which case it is added so later calls do not consult the loader again.
+// This operation is thread-safe. The loader is called without holding the lock, so a slow load does
+// not block lookups of other elements; concurrent misses for the same element wait for a single
+// loader call and share its result.
+//
+// Example:
+//
+//	s := NewLoadingSet(func(v int) bool { return v%2 == 0 })
+//	fmt.Println(s.Contains(2)) // Output: true (loaded and cached)
+//	fmt.Println(s.Contains(3)) // Output: false
+func (s *LoadingSet[T]) Contains(member T) bool {
+	s.mu.RLock()
+	_, exists := s.members[member]
+	s.mu.RUnlock()
+	if exists {
+		return true
+	}
+
+	s.mu.Lock()
+	// another goroutine may have loaded the member while the lock was released
+	if _, exists := s.members[member]; exists {
+		s.mu.Unlock()
+		return true
+	}
+	if call, loading := s.inflight[member]; loading {
+		s.mu.Unlock()
+		<-call.done
+		return call.found
+	}
+	call := &loadCall{done: make(chan struct{})}
+	s.inflight[member] = call
+	s.mu.Unlock()
+
+	// release waiting goroutines even if the loader panics
+	defer func() {
+		s.mu.Lock()
+		if call.found {
+			s.members[member] = struct{}{}
+		}
+		delete(s.inflight, member)
+		s.mu.Unlock()
+		close(call.done)
+	}()
+	call.found = s.loader(member)
+	return call.found
+}
+
+// Add inserts an element into the LoadingSet without consulting the loader.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewLoadingSet(func(v int) bool { return false })
+//	s.Add(1)
+//	fmt.Println(s.Contains(1)) // Output: true
+func (s *LoadingSet[T]) Add(member T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.members[member] = struct{}{}
+}
+
+// Remove evicts an element from the LoadingSet, so the next Contains consults the loader again.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewLoadingSet(func(v int) bool { return true })
+//	s.Contains(1)
+//	s.Remove(1) // the loader will be asked about 1 again
+func (s *LoadingSet[T]) Remove(member T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.members, member)
+}
+
+// Size returns the number of elements currently held by the LoadingSet.
+// Elements the loader would accept but that have not been looked up yet are not counted.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewLoadingSet(func(v int) bool { return true })
+//	s.Contains(1)
+//	fmt.Println(s.Size()) // Output: 1
+func (s *LoadingSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.members)
+}
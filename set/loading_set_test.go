@@ -0,0 +1,113 @@
+package set
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLoadingSet(t *testing.T) {
+	calls := map[int]int{}
+	set := NewLoadingSet(func(v int) bool {
+		calls[v]++
+		return v%2 == 0
+	})
+
+	assertEquals(t, set.Size(), 0)
+
+	assertEquals(t, set.Contains(2), true)
+	assertEquals(t, set.Contains(2), true)
+	assertEquals(t, set.Contains(4), true)
+	assertEquals(t, calls[2], 1)
+	assertEquals(t, calls[4], 1)
+	assertEquals(t, set.Size(), 2)
+
+	// Misses are not cached
+	assertEquals(t, set.Contains(3), false)
+	assertEquals(t, set.Contains(3), false)
+	assertEquals(t, calls[3], 2)
+	assertEquals(t, set.Size(), 2)
+
+	// Added members bypass the loader
+	set.Add(5)
+	assertEquals(t, set.Contains(5), true)
+	assertEquals(t, calls[5], 0)
+
+	// Removed members are loaded again
+	set.Remove(2)
+	assertEquals(t, set.Contains(2), true)
+	assertEquals(t, calls[2], 2)
+}
+
+func TestLoadingSet_Concurrent(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	set := NewLoadingSet(func(v string) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return true
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			set.Contains("foo")
+		}()
+	}
+	wg.Wait()
+
+	assertEquals(t, calls, 1)
+	assertEquals(t, set.Size(), 1)
+}
+
+func TestLoadingSet_SlowLoadDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	calls := map[string]int{}
+	set := NewLoadingSet(func(v string) bool {
+		mu.Lock()
+		calls[v]++
+		mu.Unlock()
+		if v == "A" {
+			<-release
+		}
+		return true
+	})
+	set.Add("cached")
+
+	loaded := make(chan bool, 2)
+	go func() { loaded <- set.Contains("A") }()
+	// wait until the load for A is in flight
+	for {
+		mu.Lock()
+		started := calls["A"] == 1
+		mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	go func() { loaded <- set.Contains("A") }()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assertEquals(t, set.Contains("cached"), true)
+		assertEquals(t, set.Contains("B"), true)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Contains blocked behind a slow load of another element")
+	}
+
+	close(release)
+	assertEquals(t, <-loaded, true)
+	assertEquals(t, <-loaded, true)
+	assertEquals(t, calls["A"], 1)
+	assertEquals(t, calls["B"], 1)
+	assertEquals(t, set.Size(), 3)
+}
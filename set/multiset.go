@@ -0,0 +1,134 @@
+package set
+
+import "sync"
+
+// MultiSet represents a thread-safe collection of elements where each element carries a count.
+// The zero value is not usable; use NewMultiSet to create a new MultiSet.
+type MultiSet[T comparable] struct {
+	counts map[T]int
+	mu     sync.RWMutex
+}
+
+// NewMultiSet creates and initializes a new empty MultiSet.
+//
+// Example:
+//
+//	m := NewMultiSet[string]()
+//	m.Add("foo")
+func NewMultiSet[T comparable]() *MultiSet[T] {
+	return &MultiSet[T]{
+		counts: make(map[T]int),
+	}
+}
+
+// Add increments the count of an element in the MultiSet.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	m := NewMultiSet[string]()
+//	m.Add("foo")
+//	m.Add("foo")
+//	fmt.Println(m.Count("foo")) // Output: 2
+func (m *MultiSet[T]) Add(member T) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counts[member]++
+}
+
+// Remove decrements the count of an element in the MultiSet.
+// The element is removed entirely once its count reaches zero.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	m := NewMultiSet[string]()
+//	m.Add("foo")
+//	m.Remove("foo")
+//	fmt.Println(m.Count("foo")) // Output: 0
+func (m *MultiSet[T]) Remove(member T) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.counts[member] <= 1 {
+		delete(m.counts, member)
+		return
+	}
+	m.counts[member]--
+}
+
+// Count returns the number of times an element occurs in the MultiSet, or 0 if absent.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	m := NewMultiSet[int]()
+//	m.Add(1)
+//	fmt.Println(m.Count(1)) // Output: 1
+//	fmt.Println(m.Count(2)) // Output: 0
+func (m *MultiSet[T]) Count(member T) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.counts[member]
+}
+
+// Size returns the number of distinct elements in the MultiSet.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	m := NewMultiSet[int]()
+//	m.Add(1)
+//	m.Add(1)
+//	m.Add(2)
+//	fmt.Println(m.Size()) // Output: 2
+func (m *MultiSet[T]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.counts)
+}
+
+// Members returns a slice containing each distinct element in the MultiSet once.
+// The order of elements is not guaranteed to be stable between calls.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	m := NewMultiSet[int]()
+//	m.Add(1)
+//	m.Add(1)
+//	m.Add(2)
+//	fmt.Println(m.Members()) // Output: [1 2] (order not guaranteed)
+func (m *MultiSet[T]) Members() []T {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	members := make([]T, 0, len(m.counts))
+	for member := range m.counts {
+		members = append(members, member)
+	}
+	return members
+}
+
+// UnionCounts returns a MultiSet containing every element from the given sets, where each
+// element's count is the number of input sets that contained it.
+// This operation is thread-safe and does not modify the original sets.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	result := UnionCounts(s1, s2)
+//	fmt.Println(result.Count(1), result.Count(2)) // Output: 1 2
+func UnionCounts[T comparable](sets ...*Set[T]) *MultiSet[T] {
+	result := NewMultiSet[T]()
+	for _, s := range sets {
+		s.mu.RLock()
+		for member := range s.members {
+			result.counts[member]++
+		}
+		s.mu.RUnlock()
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMultiSet_AddRemoveCount(t *testing.T) {
+	multiSet := NewMultiSet[string]()
+	assertEquals(t, multiSet.Size(), 0)
+	assertEquals(t, multiSet.Count("foo"), 0)
+
+	multiSet.Add("foo")
+	multiSet.Add("foo")
+	multiSet.Add("bar")
+	assertEquals(t, multiSet.Size(), 2)
+	assertEquals(t, multiSet.Count("foo"), 2)
+	assertEquals(t, multiSet.Count("bar"), 1)
+
+	multiSet.Remove("foo")
+	assertEquals(t, multiSet.Count("foo"), 1)
+	assertEquals(t, multiSet.Size(), 2)
+
+	multiSet.Remove("foo")
+	assertEquals(t, multiSet.Count("foo"), 0)
+	assertEquals(t, multiSet.Size(), 1)
+
+	multiSet.Remove("baz")
+	assertEquals(t, multiSet.Size(), 1)
+
+	members := multiSet.Members()
+	assertEquals(t, len(members), 1)
+	assertEquals(t, slices.Contains(members, "bar"), true)
+}
+
+func TestMultiSet_UnionCounts(t *testing.T) {
+	s1 := NewSet[int]()
+	s1.Add(1)
+	s1.Add(2)
+	s1.Add(3)
+
+	s2 := NewSet[int]()
+	s2.Add(2)
+	s2.Add(3)
+	s2.Add(4)
+
+	s3 := NewSet[int]()
+	s3.Add(3)
+	s3.Add(4)
+	s3.Add(5)
+
+	result := UnionCounts(s1, s2, s3)
+
+	assertEquals(t, result.Size(), 5)
+	assertEquals(t, result.Count(1), 1)
+	assertEquals(t, result.Count(2), 2)
+	assertEquals(t, result.Count(3), 3)
+	assertEquals(t, result.Count(4), 2)
+	assertEquals(t, result.Count(5), 1)
+	assertEquals(t, result.Count(6), 0)
+
+	assertEquals(t, UnionCounts[int]().Size(), 0)
+}
@@ -0,0 +1,44 @@
+package set
+
+// ReadOnlySet is a read-only view of a Set, exposing only non-mutating operations.
+type ReadOnlySet[T comparable] interface {
+	Contains(member T) bool
+	Size() int
+	Members() []T
+	ForEach(fn func(member T) bool)
+}
+
+// readOnlySet wraps a Set so callers cannot type-assert their way back to the mutable Set.
+type readOnlySet[T comparable] struct {
+	set *Set[T]
+}
+
+// Freeze returns a read-only view of the Set, backed by the same data without copying.
+// The view is not a snapshot: if the underlying Set is still mutated elsewhere, the changes
+// are visible through the view.
+//
+// Example:
+//
+//	s := NewSet[string]()
+//	s.Add("foo")
+//	view := s.Freeze()
+//	fmt.Println(view.Contains("foo")) // Output: true
+func (s *Set[T]) Freeze() ReadOnlySet[T] {
+	return readOnlySet[T]{set: s}
+}
+
+func (r readOnlySet[T]) Contains(member T) bool {
+	return r.set.Contains(member)
+}
+
+func (r readOnlySet[T]) Size() int {
+	return r.set.Size()
+}
+
+func (r readOnlySet[T]) Members() []T {
+	return r.set.Members()
+}
+
+func (r readOnlySet[T]) ForEach(fn func(member T) bool) {
+	r.set.ForEach(fn)
+}
@@ -0,0 +1,40 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSet_Freeze(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+
+	view := set.Freeze()
+	assertEquals(t, view.Size(), 2)
+	assertEquals(t, view.Contains(1), true)
+	assertEquals(t, view.Contains(3), false)
+
+	members := view.Members()
+	assertEquals(t, len(members), 2)
+	assertEquals(t, slices.Contains(members, 1), true)
+	assertEquals(t, slices.Contains(members, 2), true)
+
+	visited := 0
+	view.ForEach(func(member int) bool {
+		visited++
+		return true
+	})
+	assertEquals(t, visited, 2)
+
+	// Changes to the underlying set are reflected in the view
+	set.Add(3)
+	assertEquals(t, view.Size(), 3)
+	assertEquals(t, view.Contains(3), true)
+
+	// The view cannot be used, or converted back, to mutate the set
+	_, mutable := any(view).(interface{ Add(int) })
+	assertEquals(t, mutable, false)
+	_, isSet := any(view).(*Set[int])
+	assertEquals(t, isSet, false)
+}
@@ -1,28 +1,84 @@
 package set
 
-import "sync"
+import (
+	"cmp"
+	"context"
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+)
 
 // Set represents a thread-safe collection of unique elements.
 // The zero value is not usable; use NewSet to create a new Set.
 type Set[T comparable] struct {
 	members map[T]struct{}
+	order   map[T]uint64 // insertion sequence per member; nil unless WithInsertionOrder is used
+	nextSeq uint64
 	mu      sync.RWMutex
 }
 
-// NewSet creates and initializes a new empty Set.
+// Option configures a Set created by NewSet.
+type Option func(*options)
+
+type options struct {
+	insertionOrder bool
+}
+
+// WithInsertionOrder makes Members, AppendMembers, ForEach and Walk return members in the order
+// they were first added. Removing a member and adding it again moves it to the end.
+// Tracking the order costs an extra map entry per member, and Members becomes O(n log n).
+//
+// Example:
+//
+//	s := NewSet[string](WithInsertionOrder())
+//	s.Add("b")
+//	s.Add("a")
+//	fmt.Println(s.Members()) // Output: [b a]
+func WithInsertionOrder() Option {
+	return func(o *options) {
+		o.insertionOrder = true
+	}
+}
+
+// NewSet creates and initializes a new empty Set, configured by opts.
 //
 // Example:
 //
 //	s := NewSet[string]()
 //	s.Add("foo")
-func NewSet[T comparable]() *Set[T] {
-	return &Set[T]{
+func NewSet[T comparable](opts ...Option) *Set[T] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	s := &Set[T]{
 		members: make(map[T]struct{}),
 	}
+	if o.insertionOrder {
+		s.order = make(map[T]uint64)
+	}
+	return s
+}
+
+// NewSetFromSeq creates a new Set containing every value produced by seq, consuming it fully.
+// Duplicate values are stored once.
+//
+// Example:
+//
+//	s := NewSetFromSeq(slices.Values([]string{"a", "b", "a"}))
+//	fmt.Println(s.Size()) // Output: 2
+func NewSetFromSeq[T comparable](seq iter.Seq[T]) *Set[T] {
+	s := NewSet[T]()
+	for member := range seq {
+		s.insert(member)
+	}
+	return s
 }
 
 // Members returns a slice containing all elements in the Set.
-// The order of elements is not guaranteed to be stable between calls.
+// The order of elements is not guaranteed to be stable between calls, unless the Set was
+// created with WithInsertionOrder, in which case members are returned in insertion order.
 //
 // Example:
 //
@@ -33,11 +89,130 @@ func NewSet[T comparable]() *Set[T] {
 func (s *Set[T]) Members() []T {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	members := make([]T, 0, len(s.members))
+	return s.appendMembers(make([]T, 0, len(s.members)))
+}
+
+// AppendMembers appends all elements in the Set to dst and returns the extended slice.
+// Reusing a buffer across calls, e.g. s.AppendMembers(buf[:0]), avoids allocating a new slice
+// each time. The order of elements follows the same rules as Members.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	buf := make([]int, 0, 16)
+//	buf = s.AppendMembers(buf[:0])
+//	fmt.Println(buf) // Output: [1 2] (order not guaranteed)
+func (s *Set[T]) AppendMembers(dst []T) []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.appendMembers(slices.Grow(dst, len(s.members)))
+}
+
+// appendMembers appends the Set's members to dst, in insertion order if it is tracked.
+// The caller must hold at least the read lock.
+func (s *Set[T]) appendMembers(dst []T) []T {
+	start := len(dst)
 	for member := range s.members {
-		members = append(members, member)
+		dst = append(dst, member)
 	}
-	return members
+	if s.order != nil {
+		slices.SortFunc(dst[start:], func(a, b T) int {
+			return cmp.Compare(s.order[a], s.order[b])
+		})
+	}
+	return dst
+}
+
+// insert adds member to the Set, recording its position if insertion order is tracked.
+// Returns true if the member was not already present. The caller must hold the write lock.
+func (s *Set[T]) insert(member T) bool {
+	if _, exists := s.members[member]; exists {
+		return false
+	}
+	s.members[member] = struct{}{}
+	if s.order != nil {
+		s.order[member] = s.nextSeq
+		s.nextSeq++
+	}
+	return true
+}
+
+// remove deletes member from the Set and reports whether it was present.
+// The caller must hold the write lock.
+func (s *Set[T]) remove(member T) bool {
+	if _, exists := s.members[member]; !exists {
+		return false
+	}
+	delete(s.members, member)
+	if s.order != nil {
+		delete(s.order, member)
+	}
+	return true
+}
+
+// reset replaces the Set's members with members. If insertion order is tracked, it is rebuilt
+// from order, which must list every key of members at least once.
+// The caller must hold the write lock.
+func (s *Set[T]) reset(members map[T]struct{}, order []T) {
+	s.members = members
+	if s.order == nil {
+		return
+	}
+	s.order = make(map[T]uint64, len(members))
+	s.nextSeq = 0
+	for _, member := range order {
+		if _, seen := s.order[member]; !seen {
+			s.order[member] = s.nextSeq
+			s.nextSeq++
+		}
+	}
+}
+
+// ForEach calls fn for each member of the Set, stopping early if fn returns false.
+// The order of iteration follows the same rules as Members. Members are snapshotted before iterating,
+// so fn may safely call back into the Set.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	s.ForEach(func(member int) bool {
+//		fmt.Println(member) // Output: 1, 2 (order not guaranteed)
+//		return true
+//	})
+func (s *Set[T]) ForEach(fn func(member T) bool) {
+	for _, member := range s.Members() {
+		if !fn(member) {
+			return
+		}
+	}
+}
+
+// Walk calls fn for each member of the Set, stopping at and returning the first error fn returns.
+// Returns nil if fn succeeded for every member. The order of iteration follows the same rules as Members.
+// Members are snapshotted before iterating, so fn may safely call back into the Set.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[string]()
+//	s.Add("foo")
+//	err := s.Walk(func(member string) error {
+//		_, err := fmt.Fprintln(w, member)
+//		return err
+//	})
+func (s *Set[T]) Walk(fn func(member T) error) error {
+	for _, member := range s.Members() {
+		if err := fn(member); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Add inserts an element into the Set.
@@ -51,7 +226,59 @@ func (s *Set[T]) Members() []T {
 func (s *Set[T]) Add(member T) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.members[member] = struct{}{}
+	s.insert(member)
+}
+
+// AddAllReturningNew inserts all elements into the Set and returns the ones that were not
+// previously present, in input order. Duplicates within the input are only reported once.
+// This operation is thread-safe and holds a single write lock for the whole batch.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	added := s.AddAllReturningNew(1, 2, 3, 2)
+//	fmt.Println(added) // Output: [2 3]
+func (s *Set[T]) AddAllReturningNew(members ...T) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	added := make([]T, 0, len(members))
+	for _, member := range members {
+		if s.insert(member) {
+			added = append(added, member)
+		}
+	}
+	return added
+}
+
+// ApplyBatch removes every element of toRemove and then adds every element of toAdd, under a
+// single write lock, and returns how many elements were actually added and removed.
+// Because removals happen first, an element in both slices ends up present, and is counted as
+// removed and added only if it was present beforehand. Elements that are already present or
+// absent do not count.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	added, removed := s.ApplyBatch([]int{3, 4}, []int{1, 5})
+//	fmt.Println(added, removed) // Output: 2 1
+func (s *Set[T]) ApplyBatch(toAdd, toRemove []T) (added, removed int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, member := range toRemove {
+		if s.remove(member) {
+			removed++
+		}
+	}
+	for _, member := range toAdd {
+		if s.insert(member) {
+			added++
+		}
+	}
+	return added, removed
 }
 
 // Remove deletes an element from the Set.
@@ -67,7 +294,45 @@ func (s *Set[T]) Add(member T) {
 func (s *Set[T]) Remove(member T) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	delete(s.members, member)
+	s.remove(member)
+}
+
+// RemoveReturning deletes an element from the Set and reports whether it was present.
+// Returns true if the element was present and has been removed, false otherwise.
+// This operation is thread-safe and holds a single write lock.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	fmt.Println(s.RemoveReturning(1)) // Output: true
+//	fmt.Println(s.RemoveReturning(1)) // Output: false
+func (s *Set[T]) RemoveReturning(member T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.remove(member)
+}
+
+// Swap atomically replaces oldMember with newMember, but only if oldMember is present.
+// Returns true if oldMember was present and has been replaced, or false if it was absent,
+// in which case the Set remains unchanged. If newMember is already present, oldMember is
+// still removed and the Set shrinks by one.
+// This operation is thread-safe and holds a single write lock.
+//
+// Example:
+//
+//	s := NewSet[string]()
+//	s.Add("pending")
+//	fmt.Println(s.Swap("pending", "running")) // Output: true
+//	fmt.Println(s.Swap("pending", "running")) // Output: false
+func (s *Set[T]) Swap(oldMember, newMember T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.remove(oldMember) {
+		return false
+	}
+	s.insert(newMember)
+	return true
 }
 
 // Contains returns true if the element exists in the Set, false otherwise.
@@ -86,6 +351,25 @@ func (s *Set[T]) Contains(member T) bool {
 	return exists
 }
 
+// ContainsEach reports the membership of every element of members, in a slice parallel to it.
+// All lookups are made under a single read lock, so the results reflect one consistent state.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[string]()
+//	s.Add("foo")
+//	fmt.Println(s.ContainsEach([]string{"foo", "bar"})) // Output: [true false]
+func (s *Set[T]) ContainsEach(members []T) []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	results := make([]bool, len(members))
+	for i, member := range members {
+		_, results[i] = s.members[member]
+	}
+	return results
+}
+
 // Size returns the number of elements in the Set.
 // This operation is thread-safe.
 //
@@ -114,7 +398,136 @@ func (s *Set[T]) Size() int {
 func (s *Set[T]) Clear() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.members = make(map[T]struct{})
+	s.reset(make(map[T]struct{}), nil)
+}
+
+// ShrinkToFit rebuilds the Set's internal storage sized to its current number of members.
+// Go maps never release memory as entries are deleted, so a Set that grew large and then shrank
+// keeps its peak allocation, and iterating it still walks every empty bucket. Call ShrinkToFit
+// after bulk removals to return that memory; membership is unchanged.
+// This operation is thread-safe and holds the write lock while copying.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	for i := 0; i < 1_000_000; i++ {
+//		s.Add(i)
+//	}
+//	s.Subtract(others) // leaves only a handful of members
+//	s.ShrinkToFit()
+func (s *Set[T]) ShrinkToFit() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	members := make(map[T]struct{}, len(s.members))
+	for member := range s.members {
+		members[member] = struct{}{}
+	}
+	s.members = members
+	if s.order != nil {
+		order := make(map[T]uint64, len(s.order))
+		for member, seq := range s.order {
+			order[member] = seq
+		}
+		s.order = order
+	}
+}
+
+// ReplaceAll atomically replaces the contents of the Set with members.
+// The new membership is built before the write lock is taken, so concurrent readers see either
+// the old contents or the new contents, never an empty or partially populated Set.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.ReplaceAll([]int{2, 3})
+//	fmt.Println(s.Members()) // Output: [2 3] (order not guaranteed)
+func (s *Set[T]) ReplaceAll(members []T) {
+	replacement := make(map[T]struct{}, len(members))
+	for _, member := range members {
+		replacement[member] = struct{}{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reset(replacement, members)
+}
+
+// Rebuild replaces every member with the result of applying fn to it.
+// Members that transform to the same value collapse into a single element.
+// This operation is thread-safe; fn is called while the write lock is held, so it must not call back into the Set.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	s.Rebuild(func(v int) int { return v * 2 })
+//	fmt.Println(s.Members()) // Output: [2 4] (order not guaranteed)
+func (s *Set[T]) Rebuild(fn func(T) T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	members := make(map[T]struct{}, len(s.members))
+	var order []T
+	if s.order != nil {
+		order = s.appendMembers(make([]T, 0, len(s.members)))
+		for i, member := range order {
+			order[i] = fn(member)
+		}
+		for _, member := range order {
+			members[member] = struct{}{}
+		}
+	} else {
+		for member := range s.members {
+			members[fn(member)] = struct{}{}
+		}
+	}
+	s.reset(members, order)
+}
+
+// Snapshot is an immutable copy of a Set's members, taken by Snapshot and applied by Restore.
+// It is independent of the Set it came from, so later mutations of the Set do not affect it.
+type Snapshot[T comparable] struct {
+	members []T
+}
+
+// Snapshot captures the current members of the Set so they can be put back later with Restore.
+// If the Set tracks insertion order, the order is captured too.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	snap := s.Snapshot()
+//	s.Add(2)
+//	s.Restore(snap)
+//	fmt.Println(s.Members()) // Output: [1]
+func (s *Set[T]) Snapshot() Snapshot[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Snapshot[T]{members: s.appendMembers(make([]T, 0, len(s.members)))}
+}
+
+// Restore replaces the contents of the Set with the members captured in snap.
+// The snapshot itself is left untouched, so it can be restored more than once.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	snap := s.Snapshot()
+//	s.Add(1)
+//	s.Restore(snap)
+//	fmt.Println(s.Size()) // Output: 0
+func (s *Set[T]) Restore(snap Snapshot[T]) {
+	members := make(map[T]struct{}, len(snap.members))
+	for _, member := range snap.members {
+		members[member] = struct{}{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reset(members, snap.members)
 }
 
 // Intersect returns a new set containing elements that are present in both sets.
@@ -132,12 +545,22 @@ func (s *Set[T]) Clear() {
 //	fmt.Println(result.Members()) // Output: [2]
 func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
 	result := NewSet[T]()
+	if s == other {
+		// A set intersected with itself is a copy; this also avoids read-locking it twice
+		result.members = s.copyMembers()
+		return result
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	other.mu.RLock()
 	defer other.mu.RUnlock()
-	for member := range s.members {
-		if _, exists := other.members[member]; exists {
+	// Iterate the smaller set and probe the larger one
+	smaller, larger := s.members, other.members
+	if len(smaller) > len(larger) {
+		smaller, larger = larger, smaller
+	}
+	for member := range smaller {
+		if _, exists := larger[member]; exists {
 			result.Add(member)
 		}
 	}
@@ -172,6 +595,124 @@ func (s *Set[T]) Union(other *Set[T]) *Set[T] {
 	return result
 }
 
+// Then calls fn with the Set and returns the Set, so side effects such as logging can be placed
+// in the middle of a chain of set operations. Binary operations such as Union and Intersect
+// return new sets, so fn observes an intermediate result without the operands being modified.
+//
+// Example:
+//
+//	result := a.Union(b).Then(func(s *Set[int]) {
+//		log.Printf("union has %d members", s.Size())
+//	}).Intersect(c)
+func (s *Set[T]) Then(fn func(*Set[T])) *Set[T] {
+	fn(s)
+	return s
+}
+
+// IntersectAndUnionSize returns a new set containing the elements present in both sets, together
+// with the size of their union, computed in a single pass over the smaller set.
+// The union size is len(s) + len(other) - len(intersection), so the union itself is never built.
+// This operation is thread-safe and does not modify the original sets.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	s2.Add(3)
+//	intersection, unionSize := s1.IntersectAndUnionSize(s2)
+//	fmt.Println(intersection.Members()) // Output: [2]
+//	fmt.Println(unionSize)              // Output: 3
+func (s *Set[T]) IntersectAndUnionSize(other *Set[T]) (*Set[T], int) {
+	result := NewSet[T]()
+	if s == other {
+		// Both are the Set itself; this also avoids read-locking it twice
+		result.members = s.copyMembers()
+		return result, len(result.members)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+	smaller, larger := s.members, other.members
+	if len(smaller) > len(larger) {
+		smaller, larger = larger, smaller
+	}
+	for member := range smaller {
+		if _, exists := larger[member]; exists {
+			result.members[member] = struct{}{}
+		}
+	}
+	return result, len(s.members) + len(other.members) - len(result.members)
+}
+
+// UnionLazy returns an iterator over the members of both sets, without building a result Set.
+// Members of the Set are yielded first, followed by members of other that the Set does not contain.
+// The Set's members are snapshotted when iteration starts, and other is probed member by member,
+// so the loop body may safely call back into either Set.
+// This operation is thread-safe and does not modify the original sets.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	for member := range s1.UnionLazy(s2) {
+//		fmt.Println(member) // Output: 1, 2
+//	}
+func (s *Set[T]) UnionLazy(other *Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, member := range s.Members() {
+			if !yield(member) {
+				return
+			}
+		}
+		for _, member := range other.Members() {
+			if s.Contains(member) {
+				continue
+			}
+			if !yield(member) {
+				return
+			}
+		}
+	}
+}
+
+// IntersectLazy returns an iterator over the members present in both sets, without building a
+// result Set. The smaller set is snapshotted when iteration starts and each of its members is
+// probed against the larger one, so the loop body may safely call back into either Set.
+// This operation is thread-safe and does not modify the original sets.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	for member := range s1.IntersectLazy(s2) {
+//		fmt.Println(member) // Output: 2
+//	}
+func (s *Set[T]) IntersectLazy(other *Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		smaller, larger := s, other
+		if smaller.Size() > larger.Size() {
+			smaller, larger = larger, smaller
+		}
+		for _, member := range smaller.Members() {
+			if !larger.Contains(member) {
+				continue
+			}
+			if !yield(member) {
+				return
+			}
+		}
+	}
+}
+
 // Difference returns a new set containing elements that are present in the current set but not in the other set.
 // This operation is thread-safe and does not modify the original sets.
 //
@@ -200,3 +741,868 @@ func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
 	}
 	return result
 }
+
+// Equal returns true if both sets contain exactly the same elements, false otherwise.
+// Sizes are compared first, so sets of different sizes are rejected without probing members;
+// equal-sized sets are then checked member by member.
+// This operation is thread-safe and does not modify the original sets.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s2 := NewSet[int]()
+//	s2.Add(1)
+//	fmt.Println(s1.Equal(s2)) // Output: true
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	if s == other {
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+	if len(s.members) != len(other.members) {
+		return false
+	}
+	for member := range s.members {
+		if _, exists := other.members[member]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge adds every member of other to the Set in place and returns how many were newly added.
+// Unlike Union, no new Set is allocated.
+// This operation is thread-safe. other is copied under its own read lock before the Set's
+// write lock is taken, so the two locks are never held together and cannot deadlock.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	s2.Add(3)
+//	fmt.Println(s1.Merge(s2)) // Output: 1
+//	fmt.Println(s1.Members()) // Output: [1 2 3] (order not guaranteed)
+func (s *Set[T]) Merge(other *Set[T]) int {
+	members := other.copyMembers()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	added := 0
+	for member := range members {
+		if s.insert(member) {
+			added++
+		}
+	}
+	return added
+}
+
+// Update adds every member of other to the Set in place, like Python's set.update.
+// This operation is thread-safe, with the same locking as Merge.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	s1.Update(s2)
+//	fmt.Println(s1.Members()) // Output: [1 2] (order not guaranteed)
+func (s *Set[T]) Update(other *Set[T]) {
+	s.Merge(other)
+}
+
+// DifferenceUpdate removes every member of other from the Set in place,
+// like Python's set.difference_update.
+// This operation is thread-safe, with the same locking as Merge.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	s1.DifferenceUpdate(s2)
+//	fmt.Println(s1.Members()) // Output: [1]
+func (s *Set[T]) DifferenceUpdate(other *Set[T]) {
+	s.Subtract(other)
+}
+
+// Subtract removes every member of other from the Set in place and returns how many were removed.
+// It is the in-place counterpart of Difference, and the inverse of Merge; other is not modified.
+// This operation is thread-safe, with the same locking as Merge.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	s2.Add(3)
+//	fmt.Println(s1.Subtract(s2)) // Output: 1
+//	fmt.Println(s1.Members())    // Output: [1]
+func (s *Set[T]) Subtract(other *Set[T]) int {
+	members := other.copyMembers()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	removed := 0
+	for member := range members {
+		if s.remove(member) {
+			removed++
+		}
+	}
+	return removed
+}
+
+// IntersectionUpdate removes every member of the Set that is not in other,
+// like Python's set.intersection_update.
+// This operation is thread-safe, with the same locking as Merge.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	s1.IntersectionUpdate(s2)
+//	fmt.Println(s1.Members()) // Output: [2]
+func (s *Set[T]) IntersectionUpdate(other *Set[T]) {
+	members := other.copyMembers()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for member := range s.members {
+		if _, exists := members[member]; !exists {
+			s.remove(member)
+		}
+	}
+}
+
+// SymmetricDifferenceUpdate leaves the Set containing only elements found in exactly one of
+// the Set and other, like Python's set.symmetric_difference_update.
+// This operation is thread-safe, with the same locking as Merge.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	s2.Add(3)
+//	s1.SymmetricDifferenceUpdate(s2)
+//	fmt.Println(s1.Members()) // Output: [1 3] (order not guaranteed)
+func (s *Set[T]) SymmetricDifferenceUpdate(other *Set[T]) {
+	members := other.copyMembers()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for member := range members {
+		if !s.remove(member) {
+			s.insert(member)
+		}
+	}
+}
+
+// copyMembers returns a copy of the Set's underlying map, taken under a read lock.
+func (s *Set[T]) copyMembers() map[T]struct{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return maps.Clone(s.members)
+}
+
+// IsProperSubset returns true if every member of the Set is in other and other has additional
+// members, false otherwise. Equal sets are not proper subsets of each other.
+// Sizes are compared first, so most non-subsets are rejected without probing members.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s2 := NewSet[int]()
+//	s2.Add(1)
+//	s2.Add(2)
+//	fmt.Println(s1.IsProperSubset(s2)) // Output: true
+//	fmt.Println(s2.IsProperSubset(s2)) // Output: false
+func (s *Set[T]) IsProperSubset(other *Set[T]) bool {
+	if s == other {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+	if len(s.members) >= len(other.members) {
+		return false
+	}
+	for member := range s.members {
+		if _, exists := other.members[member]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSuperset returns true if the Set contains every member of other and has additional
+// members, false otherwise. Equal sets are not proper supersets of each other.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(1)
+//	fmt.Println(s1.IsProperSuperset(s2)) // Output: true
+func (s *Set[T]) IsProperSuperset(other *Set[T]) bool {
+	return other.IsProperSubset(s)
+}
+
+// IntersectMap returns a new set containing elements present in both the Set and the keys of m.
+// It behaves like Intersect, without having to wrap m in a Set first.
+// This operation is thread-safe and modifies neither the Set nor m.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	result := s.IntersectMap(map[int]struct{}{2: {}, 3: {}})
+//	fmt.Println(result.Members()) // Output: [2]
+func (s *Set[T]) IntersectMap(m map[T]struct{}) *Set[T] {
+	result := NewSet[T]()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for member := range s.members {
+		if _, exists := m[member]; exists {
+			result.members[member] = struct{}{}
+		}
+	}
+	return result
+}
+
+// UnionMap returns a new set containing all elements of the Set and all keys of m.
+// It behaves like Union, without having to wrap m in a Set first.
+// This operation is thread-safe and modifies neither the Set nor m.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	result := s.UnionMap(map[int]struct{}{2: {}})
+//	fmt.Println(result.Members()) // Output: [1 2]
+func (s *Set[T]) UnionMap(m map[T]struct{}) *Set[T] {
+	result := NewSet[T]()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for member := range s.members {
+		result.members[member] = struct{}{}
+	}
+	for member := range m {
+		result.members[member] = struct{}{}
+	}
+	return result
+}
+
+// DifferenceMap returns a new set containing elements of the Set that are not keys of m.
+// It behaves like Difference, without having to wrap m in a Set first.
+// This operation is thread-safe and modifies neither the Set nor m.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	result := s.DifferenceMap(map[int]struct{}{2: {}})
+//	fmt.Println(result.Members()) // Output: [1]
+func (s *Set[T]) DifferenceMap(m map[T]struct{}) *Set[T] {
+	result := NewSet[T]()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for member := range s.members {
+		if _, exists := m[member]; !exists {
+			result.members[member] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Diff returns both one-sided differences in a single call: the elements only present in the
+// current set, and the elements only present in the other set.
+// This is equivalent to, but cheaper than, calling s.Difference(other) and other.Difference(s).
+// This operation is thread-safe and does not modify the original sets.
+//
+// Example:
+//
+//	desired := NewSet[int]()
+//	desired.Add(1)
+//	desired.Add(2)
+//	actual := NewSet[int]()
+//	actual.Add(2)
+//	actual.Add(3)
+//	toAdd, toRemove := desired.Diff(actual)
+//	fmt.Println(toAdd.Members())    // Output: [1]
+//	fmt.Println(toRemove.Members()) // Output: [3]
+func (s *Set[T]) Diff(other *Set[T]) (onlyInS, onlyInOther *Set[T]) {
+	onlyInS = NewSet[T]()
+	onlyInOther = NewSet[T]()
+	if s == other {
+		// A set never differs from itself; this also avoids read-locking it twice
+		return onlyInS, onlyInOther
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+	for member := range s.members {
+		if _, exists := other.members[member]; !exists {
+			onlyInS.members[member] = struct{}{}
+		}
+	}
+	for member := range other.members {
+		if _, exists := s.members[member]; !exists {
+			onlyInOther.members[member] = struct{}{}
+		}
+	}
+	return onlyInS, onlyInOther
+}
+
+// ChangeKind identifies whether a ChangeEvent adds or removes a member.
+type ChangeKind int
+
+const (
+	// ChangeAdd indicates the member should be added.
+	ChangeAdd ChangeKind = iota
+	// ChangeRemove indicates the member should be removed.
+	ChangeRemove
+)
+
+// ChangeEvent describes a single member being added to or removed from a Set.
+type ChangeEvent[T comparable] struct {
+	Kind   ChangeKind
+	Member T
+}
+
+// DiffEvents returns the add and remove events that would transform the Set into newState.
+// All removals are listed before all additions; the order within each group is not guaranteed.
+// Replaying the events in order against a copy of the Set yields a Set equal to newState.
+// This operation is thread-safe and does not modify either Set.
+//
+// Example:
+//
+//	old := NewSet[int]()
+//	old.Add(1)
+//	old.Add(2)
+//	next := NewSet[int]()
+//	next.Add(2)
+//	next.Add(3)
+//	events := old.DiffEvents(next)
+//	fmt.Println(events) // Output: [{1 1} {0 3}]
+func (s *Set[T]) DiffEvents(newState *Set[T]) []ChangeEvent[T] {
+	removed, added := s.Diff(newState)
+	events := make([]ChangeEvent[T], 0, len(removed.members)+len(added.members))
+	for member := range removed.members {
+		events = append(events, ChangeEvent[T]{Kind: ChangeRemove, Member: member})
+	}
+	for member := range added.members {
+		events = append(events, ChangeEvent[T]{Kind: ChangeAdd, Member: member})
+	}
+	return events
+}
+
+// PartitionBy splits the Set into n new sets, placing each member in the set at index
+// bucket(member) modulo n. Negative bucket values wrap around, so every member lands in exactly
+// one of the returned sets and their sizes sum to the size of the Set.
+// Returns nil if n is less than 1.
+// This operation is thread-safe and does not modify the original set; bucket is called while
+// the read lock is held, so it must not modify the Set.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	for i := 0; i < 6; i++ {
+//		s.Add(i)
+//	}
+//	shards := s.PartitionBy(3, func(v int) int { return v })
+//	fmt.Println(shards[0].Members()) // Output: [0 3] (order not guaranteed)
+func (s *Set[T]) PartitionBy(n int, bucket func(T) int) []*Set[T] {
+	if n < 1 {
+		return nil
+	}
+	partitions := make([]*Set[T], n)
+	for i := range partitions {
+		partitions[i] = NewSet[T]()
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for member := range s.members {
+		i := bucket(member) % n
+		if i < 0 {
+			i += n
+		}
+		partitions[i].members[member] = struct{}{}
+	}
+	return partitions
+}
+
+// SubsetOfSlice returns true if every member of the Set appears in universe, false otherwise.
+// An empty Set is a subset of any slice.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[string]()
+//	s.Add("read")
+//	fmt.Println(s.SubsetOfSlice([]string{"read", "write"})) // Output: true
+func (s *Set[T]) SubsetOfSlice(universe []T) bool {
+	lookup := make(map[T]struct{}, len(universe))
+	for _, member := range universe {
+		lookup[member] = struct{}{}
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for member := range s.members {
+		if _, exists := lookup[member]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// SupersetOfSlice returns true if every element of required is a member of the Set, false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[string]()
+//	s.Add("read")
+//	s.Add("write")
+//	fmt.Println(s.SupersetOfSlice([]string{"read"}))          // Output: true
+//	fmt.Println(s.SupersetOfSlice([]string{"read", "admin"})) // Output: false
+func (s *Set[T]) SupersetOfSlice(required []T) bool {
+	for _, member := range required {
+		if !s.Contains(member) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterSlice returns the elements of items that are members of the Set, in their original order.
+// Duplicates in items are kept.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	allowed := NewSet[int]()
+//	allowed.Add(1)
+//	allowed.Add(3)
+//	fmt.Println(allowed.FilterSlice([]int{3, 2, 1, 3})) // Output: [3 1 3]
+func (s *Set[T]) FilterSlice(items []T) []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		if _, exists := s.members[item]; exists {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// CloneFiltered returns an independent copy of the Set containing only the members for which keep
+// returns true, built in a single pass. A nil keep copies every member. The copy tracks insertion
+// order if the Set does, keeping the members' relative order.
+// This operation is thread-safe and does not modify the original set; keep is called while the
+// read lock is held, so it must not modify the Set.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	evens := s.CloneFiltered(func(v int) bool { return v%2 == 0 })
+//	fmt.Println(evens.Members()) // Output: [2]
+func (s *Set[T]) CloneFiltered(keep func(T) bool) *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	clone := &Set[T]{
+		members: make(map[T]struct{}, len(s.members)),
+		nextSeq: s.nextSeq,
+	}
+	if s.order != nil {
+		clone.order = make(map[T]uint64, len(s.order))
+	}
+	for member := range s.members {
+		if keep != nil && !keep(member) {
+			continue
+		}
+		clone.members[member] = struct{}{}
+		if clone.order != nil {
+			clone.order[member] = s.order[member]
+		}
+	}
+	return clone
+}
+
+// FilterInto clears dst and fills it with the members of the Set for which keep returns true.
+// dst's existing storage is reused, so filtering repeatedly into a pooled Set avoids allocating
+// a new map each time. dst may be the Set itself, in which case it is filtered in place.
+// This operation is thread-safe. Matching members are collected under the Set's read lock before
+// dst's write lock is taken, so the two locks are never held together; keep is called while the
+// Set is locked, so it must not modify the Set.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	evens := NewSet[int]()
+//	s.FilterInto(evens, func(v int) bool { return v%2 == 0 })
+//	fmt.Println(evens.Members()) // Output: [2]
+func (s *Set[T]) FilterInto(dst *Set[T], keep func(T) bool) {
+	if dst == s {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for member := range s.members {
+			if !keep(member) {
+				s.remove(member)
+			}
+		}
+		return
+	}
+
+	s.mu.RLock()
+	kept := make([]T, 0, len(s.members))
+	for member := range s.members {
+		if keep(member) {
+			kept = append(kept, member)
+		}
+	}
+	s.mu.RUnlock()
+
+	dst.mu.Lock()
+	defer dst.mu.Unlock()
+	clear(dst.members)
+	if dst.order != nil {
+		clear(dst.order)
+		dst.nextSeq = 0
+	}
+	for _, member := range kept {
+		dst.insert(member)
+	}
+}
+
+// Stream returns a channel that receives every member of the Set and is closed once all
+// members have been sent or ctx is cancelled, whichever comes first.
+// Members are snapshotted under a read lock before sending, so the Set is not locked while
+// the consumer reads and may be modified freely during streaming.
+// Cancel ctx if you stop reading early, otherwise the producing goroutine is leaked.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	for member := range s.Stream(ctx) {
+//		fmt.Println(member) // Output: 1, 2 (order not guaranteed)
+//	}
+func (s *Set[T]) Stream(ctx context.Context) <-chan T {
+	members := s.Members()
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for _, member := range members {
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case ch <- member:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// IntersectAll returns a new set containing elements present in every one of the given sets.
+// Returns an empty set if no sets are given. Once the running intersection becomes empty the
+// remaining sets are not examined, so an early disjoint set avoids scanning the rest.
+// This operation is thread-safe and does not modify the original sets.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	s2.Add(3)
+//	s3 := NewSet[int]()
+//	s3.Add(2)
+//	fmt.Println(IntersectAll(s1, s2, s3).Members()) // Output: [2]
+func IntersectAll[T comparable](sets ...*Set[T]) *Set[T] {
+	result := NewSet[T]()
+	if len(sets) == 0 {
+		return result
+	}
+	result.members = sets[0].copyMembers()
+	for _, s := range sets[1:] {
+		if len(result.members) == 0 {
+			return result
+		}
+		s.mu.RLock()
+		for member := range result.members {
+			if _, exists := s.members[member]; !exists {
+				delete(result.members, member)
+			}
+		}
+		s.mu.RUnlock()
+	}
+	return result
+}
+
+// CountDistinct returns the number of distinct elements across all the given sets,
+// equivalent to the size of their union without building a result Set.
+// This operation is thread-safe and does not modify the original sets.
+//
+// Example:
+//
+//	s1 := NewSet[int]()
+//	s1.Add(1)
+//	s1.Add(2)
+//	s2 := NewSet[int]()
+//	s2.Add(2)
+//	s2.Add(3)
+//	fmt.Println(CountDistinct(s1, s2)) // Output: 3
+func CountDistinct[T comparable](sets ...*Set[T]) int {
+	seen := make(map[T]struct{})
+	for _, s := range sets {
+		s.mu.RLock()
+		for member := range s.members {
+			seen[member] = struct{}{}
+		}
+		s.mu.RUnlock()
+	}
+	return len(seen)
+}
+
+// TransformKeys returns a new set containing fn applied to every member of s, along with the
+// number of collisions: members whose transformed value was already produced by another member.
+// A non-zero collision count means the transformation is lossy for this set.
+// This operation is thread-safe and does not modify the original set.
+//
+// Example:
+//
+//	s := NewSet[string]()
+//	s.Add("A")
+//	s.Add("a")
+//	s.Add("b")
+//	result, collisions := TransformKeys(s, strings.ToLower)
+//	fmt.Println(result.Members(), collisions) // Output: [a b] 1
+func TransformKeys[T comparable](s *Set[T], fn func(T) T) (*Set[T], int) {
+	result := NewSet[T]()
+	collisions := 0
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for member := range s.members {
+		transformed := fn(member)
+		if _, exists := result.members[transformed]; exists {
+			collisions++
+			continue
+		}
+		result.members[transformed] = struct{}{}
+	}
+	return result, collisions
+}
+
+// EqualNormalized returns true if a and b contain the same elements once norm has been applied to
+// every member of both, false otherwise. Members that normalize to the same value count once, so
+// {"A", "a"} and {"a"} are equal under a lowercasing norm.
+// This operation is thread-safe and does not modify either set.
+//
+// Example:
+//
+//	a := NewSet[string]()
+//	a.Add("A")
+//	a.Add("b")
+//	b := NewSet[string]()
+//	b.Add("a")
+//	b.Add("B")
+//	fmt.Println(EqualNormalized(a, b, strings.ToLower)) // Output: true
+func EqualNormalized[T comparable](a, b *Set[T], norm func(T) T) bool {
+	normalizedA, _ := TransformKeys(a, norm)
+	normalizedB, _ := TransformKeys(b, norm)
+	return normalizedA.Equal(normalizedB)
+}
+
+// GroupBy returns a new set per key, holding the members of s for which key returned that key.
+// Unlike PartitionBy, the groups are keyed by value rather than by a fixed number of buckets, and
+// only keys that at least one member produced are present.
+// This operation is thread-safe and does not modify the original set; key is called while the
+// read lock is held, so it must not modify s.
+//
+// Example:
+//
+//	s := NewSet[string]()
+//	s.Add("apple")
+//	s.Add("avocado")
+//	s.Add("banana")
+//	groups := GroupBy(s, func(v string) byte { return v[0] })
+//	fmt.Println(groups['a'].Members()) // Output: [apple avocado] (order not guaranteed)
+func GroupBy[T comparable, K comparable](s *Set[T], key func(T) K) map[K]*Set[T] {
+	groups := make(map[K]*Set[T])
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for member := range s.members {
+		k := key(member)
+		group, exists := groups[k]
+		if !exists {
+			group = NewSet[T]()
+			groups[k] = group
+		}
+		group.members[member] = struct{}{}
+	}
+	return groups
+}
+
+// MapToSlice returns a slice containing fn applied to every member of s. Unlike TransformKeys,
+// results are not deduplicated, so the slice always has one entry per member.
+// The order of the slice is not guaranteed.
+// This operation is thread-safe; fn is called while the read lock is held, so it must not modify s.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	labels := MapToSlice(s, strconv.Itoa)
+//	fmt.Println(labels) // Output: [1 2] (order not guaranteed)
+func MapToSlice[T comparable, U any](s *Set[T], fn func(T) U) []U {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make([]U, 0, len(s.members))
+	for member := range s.members {
+		result = append(result, fn(member))
+	}
+	return result
+}
+
+// Min returns the smallest element in the Set.
+// Returns the element and true if successful, or zero value and false if the Set is empty.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(3)
+//	s.Add(1)
+//	s.Add(2)
+//	val, ok := Min(s) // val = 1, ok = true
+func Min[T cmp.Ordered](s *Set[T]) (T, bool) {
+	return extreme(s, func(a, b T) bool { return a < b })
+}
+
+// Max returns the largest element in the Set.
+// Returns the element and true if successful, or zero value and false if the Set is empty.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(3)
+//	s.Add(1)
+//	s.Add(2)
+//	val, ok := Max(s) // val = 3, ok = true
+func Max[T cmp.Ordered](s *Set[T]) (T, bool) {
+	return extreme(s, func(a, b T) bool { return a > b })
+}
+
+// Number is a constraint satisfied by all integer and floating-point types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum returns the sum of all elements in the Set, or 0 for an empty Set.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	s.Add(3)
+//	fmt.Println(Sum(s)) // Output: 6
+func Sum[T Number](s *Set[T]) T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var sum T
+	for member := range s.members {
+		sum += member
+	}
+	return sum
+}
+
+// Average returns the arithmetic mean of all elements in the Set.
+// The average of an empty Set is 0.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(1)
+//	s.Add(2)
+//	fmt.Println(Average(s)) // Output: 1.5
+func Average[T Number](s *Set[T]) float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.members) == 0 {
+		return 0
+	}
+	var sum float64
+	for member := range s.members {
+		sum += float64(member)
+	}
+	return sum / float64(len(s.members))
+}
+
+// SortedMembers returns a slice containing all elements in the Set, sorted in ascending order.
+// This operation is thread-safe and does not modify the Set.
+//
+// Example:
+//
+//	s := NewSet[int]()
+//	s.Add(3)
+//	s.Add(1)
+//	s.Add(2)
+//	fmt.Println(SortedMembers(s)) // Output: [1 2 3]
+func SortedMembers[T cmp.Ordered](s *Set[T]) []T {
+	members := s.Members()
+	slices.Sort(members)
+	return members
+}
+
+// extreme scans the Set once and returns the member for which better reports true against all others.
+func extreme[T comparable](s *Set[T], better func(a, b T) bool) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var result T
+	found := false
+	for member := range s.members {
+		if !found || better(member, result) {
+			result = member
+			found = true
+		}
+	}
+	return result, found
+}
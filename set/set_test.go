@@ -1,10 +1,30 @@
 package set
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 )
 
+func TestNewSetFromSeq(t *testing.T) {
+	set := NewSetFromSeq(slices.Values([]string{"a", "b", "a", "c", "b"}))
+
+	assertEquals(t, set.Size(), 3)
+	assertEquals(t, set.Contains("a"), true)
+	assertEquals(t, set.Contains("b"), true)
+	assertEquals(t, set.Contains("c"), true)
+
+	empty := NewSetFromSeq(slices.Values([]int{}))
+	assertEquals(t, empty.Size(), 0)
+	empty.Add(1)
+	assertEquals(t, empty.Size(), 1)
+}
+
 func TestSet_AddRemoveSize(t *testing.T) {
 	set := NewSet[int]()
 	assertEquals(t, set.Size(), 0)
@@ -24,6 +44,203 @@ func TestSet_AddRemoveSize(t *testing.T) {
 	assertEquals(t, set.Contains(1), false)
 }
 
+func TestSet_ContainsEach(t *testing.T) {
+	set := NewSet[string]()
+	set.Add("read")
+	set.Add("admin")
+
+	results := set.ContainsEach([]string{"read", "write", "admin", "read", "delete"})
+	assertEquals(t, slices.Equal(results, []bool{true, false, true, true, false}), true)
+
+	assertEquals(t, len(set.ContainsEach(nil)), 0)
+}
+
+func TestSet_Walk(t *testing.T) {
+	set := NewSet[int]()
+	for i := 1; i <= 5; i++ {
+		set.Add(i)
+	}
+
+	visited := 0
+	err := set.Walk(func(member int) error {
+		visited++
+		return nil
+	})
+	assertEquals(t, err, nil)
+	assertEquals(t, visited, 5)
+
+	errSink := errors.New("sink unavailable")
+	visited = 0
+	err = set.Walk(func(member int) error {
+		visited++
+		if visited == 3 {
+			return errSink
+		}
+		return nil
+	})
+	assertEquals(t, err, errSink)
+	assertEquals(t, visited, 3)
+
+	// Calling back into the set does not deadlock
+	err = set.Walk(func(member int) error {
+		set.Remove(member)
+		return nil
+	})
+	assertEquals(t, err, nil)
+	assertEquals(t, set.Size(), 0)
+}
+
+func TestSet_RemoveReturning(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+
+	assertEquals(t, set.RemoveReturning(1), true)
+	assertEquals(t, set.Contains(1), false)
+	assertEquals(t, set.Size(), 1)
+
+	assertEquals(t, set.RemoveReturning(1), false)
+	assertEquals(t, set.RemoveReturning(3), false)
+	assertEquals(t, set.Size(), 1)
+}
+
+func TestSet_Swap(t *testing.T) {
+	set := NewSet[string]()
+	set.Add("pending")
+	set.Add("done")
+
+	assertEquals(t, set.Swap("pending", "running"), true)
+	assertEquals(t, set.Contains("pending"), false)
+	assertEquals(t, set.Contains("running"), true)
+	assertEquals(t, set.Size(), 2)
+
+	assertEquals(t, set.Swap("pending", "failed"), false)
+	assertEquals(t, set.Contains("failed"), false)
+	assertEquals(t, set.Size(), 2)
+
+	assertEquals(t, set.Swap("running", "done"), true)
+	assertEquals(t, set.Contains("running"), false)
+	assertEquals(t, set.Contains("done"), true)
+	assertEquals(t, set.Size(), 1)
+
+	assertEquals(t, set.Swap("done", "done"), true)
+	assertEquals(t, set.Contains("done"), true)
+	assertEquals(t, set.Size(), 1)
+}
+
+func TestSet_AppendMembers(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+	set.Add(3)
+
+	buf := make([]int, 0, 8)
+	for i := 0; i < 3; i++ {
+		buf = set.AppendMembers(buf[:0])
+		assertEquals(t, len(buf), 3)
+		assertEquals(t, cap(buf), 8)
+	}
+	slices.Sort(buf)
+	assertEquals(t, slices.Equal(buf, []int{1, 2, 3}), true)
+
+	prefixed := set.AppendMembers([]int{0})
+	assertEquals(t, len(prefixed), 4)
+	assertEquals(t, prefixed[0], 0)
+}
+
+func BenchmarkSet_Members(b *testing.B) {
+	set := NewSet[int]()
+	for i := 0; i < 100; i++ {
+		set.Add(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = set.Members()
+	}
+}
+
+func BenchmarkSet_AppendMembers(b *testing.B) {
+	set := NewSet[int]()
+	for i := 0; i < 100; i++ {
+		set.Add(i)
+	}
+	buf := make([]int, 0, 100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = set.AppendMembers(buf[:0])
+	}
+}
+
+func TestSet_ForEach(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+	set.Add(3)
+
+	visited := make([]int, 0)
+	set.ForEach(func(member int) bool {
+		visited = append(visited, member)
+		return true
+	})
+	assertEquals(t, len(visited), 3)
+
+	visited = visited[:0]
+	set.ForEach(func(member int) bool {
+		visited = append(visited, member)
+		return len(visited) < 2
+	})
+	assertEquals(t, len(visited), 2)
+
+	// Calling back into the set does not deadlock
+	set.ForEach(func(member int) bool {
+		set.Remove(member)
+		return true
+	})
+	assertEquals(t, set.Size(), 0)
+}
+
+func TestSet_AddAllReturningNew(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+
+	added := set.AddAllReturningNew(2, 3, 1, 4)
+	assertEquals(t, slices.Equal(added, []int{3, 4}), true)
+	assertEquals(t, set.Size(), 4)
+
+	added = set.AddAllReturningNew(5, 5, 4, 6, 6)
+	assertEquals(t, slices.Equal(added, []int{5, 6}), true)
+	assertEquals(t, set.Size(), 6)
+
+	added = set.AddAllReturningNew(1, 2, 3)
+	assertEquals(t, len(added), 0)
+	assertEquals(t, set.Size(), 6)
+}
+
+func TestSet_ApplyBatch(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+
+	added, removed := set.ApplyBatch([]int{3, 4, 3}, []int{1, 5})
+	assertEquals(t, added, 2)
+	assertEquals(t, removed, 1)
+	assertSameMembers(t, set, NewSetFromSeq(slices.Values([]int{2, 3, 4})))
+
+	// Removals happen first, so overlapping elements end up present
+	added, removed = set.ApplyBatch([]int{2, 6}, []int{2, 6})
+	assertEquals(t, added, 2)
+	assertEquals(t, removed, 1)
+	assertSameMembers(t, set, NewSetFromSeq(slices.Values([]int{2, 3, 4, 6})))
+
+	added, removed = set.ApplyBatch([]int{2}, []int{7})
+	assertEquals(t, added, 0)
+	assertEquals(t, removed, 0)
+	assertEquals(t, set.Size(), 4)
+}
+
 func TestSet_Members(t *testing.T) {
 	set := NewSet[int]()
 	set.Add(1)
@@ -38,6 +255,49 @@ func TestSet_Members(t *testing.T) {
 	assertEquals(t, slices.Contains(members, 3), true)
 }
 
+func TestSet_WithInsertionOrder(t *testing.T) {
+	set := NewSet[string](WithInsertionOrder())
+	set.Add("c")
+	set.Add("a")
+	set.Add("b")
+	set.Add("a")
+	assertEquals(t, slices.Equal(set.Members(), []string{"c", "a", "b"}), true)
+	assertEquals(t, slices.Equal(set.AppendMembers([]string{"x"}), []string{"x", "c", "a", "b"}), true)
+
+	// Re-adding a removed member moves it to the end
+	set.Remove("c")
+	set.Add("c")
+	assertEquals(t, slices.Equal(set.Members(), []string{"a", "b", "c"}), true)
+
+	set.Swap("a", "d")
+	assertEquals(t, slices.Equal(set.Members(), []string{"b", "c", "d"}), true)
+
+	var visited []string
+	set.ForEach(func(member string) bool {
+		visited = append(visited, member)
+		return true
+	})
+	assertEquals(t, slices.Equal(visited, []string{"b", "c", "d"}), true)
+
+	set.ReplaceAll([]string{"z", "y", "z"})
+	assertEquals(t, slices.Equal(set.Members(), []string{"z", "y"}), true)
+
+	set.Rebuild(strings.ToUpper)
+	assertEquals(t, slices.Equal(set.Members(), []string{"Z", "Y"}), true)
+
+	set.Clear()
+	set.Add("q")
+	assertEquals(t, slices.Equal(set.Members(), []string{"q"}), true)
+
+	// The default Set is unaffected
+	unordered := NewSet[int]()
+	unordered.Add(2)
+	unordered.Add(1)
+	assertEquals(t, len(unordered.Members()), 2)
+	assertEquals(t, unordered.Contains(1), true)
+	assertEquals(t, unordered.order == nil, true)
+}
+
 func TestSet_Clear(t *testing.T) {
 	set := NewSet[int]()
 	set.Add(1)
@@ -54,6 +314,173 @@ func TestSet_Clear(t *testing.T) {
 	assertEquals(t, set.Contains(3), false)
 }
 
+func TestSet_ShrinkToFit(t *testing.T) {
+	set := NewSet[int](WithInsertionOrder())
+	for i := 0; i < 10000; i++ {
+		set.Add(i)
+	}
+	for i := 0; i < 9995; i++ {
+		set.Remove(i)
+	}
+
+	set.ShrinkToFit()
+	assertEquals(t, set.Size(), 5)
+	assertEquals(t, slices.Equal(set.Members(), []int{9995, 9996, 9997, 9998, 9999}), true)
+	assertEquals(t, set.Contains(0), false)
+
+	set.Add(1)
+	assertEquals(t, set.Members()[5], 1)
+}
+
+func BenchmarkSet_ShrinkToFit(b *testing.B) {
+	shrunk := func(shrink bool) *Set[int] {
+		set := NewSet[int]()
+		for i := 0; i < 1_000_000; i++ {
+			set.Add(i)
+		}
+		for i := 10; i < 1_000_000; i++ {
+			set.Remove(i)
+		}
+		if shrink {
+			set.ShrinkToFit()
+		}
+		return set
+	}
+
+	// Iterating walks every bucket, so a shrunk Set is much faster to read
+	for _, shrink := range []bool{false, true} {
+		set := shrunk(shrink)
+		b.Run(fmt.Sprintf("shrink=%v", shrink), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = set.Members()
+			}
+		})
+	}
+}
+
+func TestSet_ReplaceAll(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+
+	set.ReplaceAll([]int{3, 4, 4, 5})
+	assertEquals(t, set.Size(), 3)
+	assertEquals(t, set.Contains(1), false)
+	assertEquals(t, set.Contains(4), true)
+
+	set.ReplaceAll(nil)
+	assertEquals(t, set.Size(), 0)
+	set.Add(6)
+	assertEquals(t, set.Size(), 1)
+}
+
+func TestSet_ReplaceAll_Concurrent(t *testing.T) {
+	evens := make([]int, 0, 100)
+	odds := make([]int, 0, 100)
+	for i := 0; i < 100; i++ {
+		evens = append(evens, i*2)
+		odds = append(odds, i*2+1)
+	}
+
+	set := NewSet[int]()
+	set.ReplaceAll(evens)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				members := set.Members()
+				if len(members) != 100 {
+					t.Errorf("observed %d members during replace, want 100", len(members))
+					return
+				}
+				parity := members[0] % 2
+				for _, member := range members {
+					if member%2 != parity {
+						t.Errorf("observed a mix of old and new members during replace")
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 500; i++ {
+		if i%2 == 0 {
+			set.ReplaceAll(odds)
+		} else {
+			set.ReplaceAll(evens)
+		}
+	}
+	close(done)
+	wg.Wait()
+}
+
+func TestSet_Rebuild(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+	set.Add(3)
+
+	set.Rebuild(func(v int) int { return v * 2 })
+
+	assertEquals(t, set.Size(), 3)
+	assertEquals(t, set.Contains(1), false)
+	assertEquals(t, set.Contains(2), true)
+	assertEquals(t, set.Contains(4), true)
+	assertEquals(t, set.Contains(6), true)
+
+	// Members mapping to the same value collapse
+	set.Rebuild(func(v int) int { return v / 4 })
+
+	assertEquals(t, set.Size(), 2)
+	assertEquals(t, set.Contains(0), true)
+	assertEquals(t, set.Contains(1), true)
+}
+
+func TestSet_SnapshotRestore(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+
+	snap := set.Snapshot()
+
+	set.Add(3)
+	set.Remove(1)
+	set.Clear()
+	set.Add(4)
+	assertEquals(t, set.Size(), 1)
+
+	set.Restore(snap)
+	assertEquals(t, set.Size(), 2)
+	assertEquals(t, set.Contains(1), true)
+	assertEquals(t, set.Contains(2), true)
+	assertEquals(t, set.Contains(4), false)
+
+	// The snapshot is unaffected by mutations after a restore
+	set.Add(5)
+	set.Restore(snap)
+	assertEquals(t, set.Size(), 2)
+	assertEquals(t, set.Contains(5), false)
+
+	ordered := NewSet[string](WithInsertionOrder())
+	ordered.Add("b")
+	ordered.Add("a")
+	orderedSnap := ordered.Snapshot()
+	ordered.Remove("b")
+	ordered.Add("b")
+	ordered.Restore(orderedSnap)
+	assertEquals(t, slices.Equal(ordered.Members(), []string{"b", "a"}), true)
+}
+
 func TestSet_Intersect(t *testing.T) {
 	s1 := NewSet[int]()
 	s1.Add(1)
@@ -73,6 +500,59 @@ func TestSet_Intersect(t *testing.T) {
 	assertEquals(t, slices.Contains(members, 2), true)
 	assertEquals(t, slices.Contains(members, 3), true)
 	assertEquals(t, slices.Contains(members, 4), false)
+
+	self := s1.Intersect(s1)
+	assertSameMembers(t, self, s1)
+	self.Add(9)
+	assertEquals(t, s1.Contains(9), false)
+}
+
+func TestSet_Intersect_SmallerFirst(t *testing.T) {
+	small := NewSet[int]()
+	small.Add(5)
+	small.Add(50)
+	small.Add(5000)
+
+	large := NewSet[int]()
+	for i := 0; i < 1000; i++ {
+		large.Add(i)
+	}
+
+	// The result is the same whichever set is the receiver
+	assertSameMembers(t, small.Intersect(large), large.Intersect(small))
+	result := small.Intersect(large)
+	assertEquals(t, result.Size(), 2)
+	assertEquals(t, result.Contains(5), true)
+	assertEquals(t, result.Contains(50), true)
+	assertEquals(t, result.Contains(5000), false)
+}
+
+func benchmarkIntersectSets() (*Set[int], *Set[int]) {
+	small := NewSet[int]()
+	for i := 0; i < 10; i++ {
+		small.Add(i * 1000)
+	}
+	large := NewSet[int]()
+	for i := 0; i < 1_000_000; i++ {
+		large.Add(i)
+	}
+	return small, large
+}
+
+func BenchmarkSet_Intersect_SmallWithLarge(b *testing.B) {
+	small, large := benchmarkIntersectSets()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		small.Intersect(large)
+	}
+}
+
+func BenchmarkSet_Intersect_LargeWithSmall(b *testing.B) {
+	small, large := benchmarkIntersectSets()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		large.Intersect(small)
+	}
 }
 
 func TestSet_Union(t *testing.T) {
@@ -96,6 +576,96 @@ func TestSet_Union(t *testing.T) {
 	assertEquals(t, slices.Contains(members, 4), true)
 }
 
+func TestSet_Then(t *testing.T) {
+	a := NewSetFromSeq(slices.Values([]int{1, 2}))
+	b := NewSetFromSeq(slices.Values([]int{2, 3}))
+	c := NewSetFromSeq(slices.Values([]int{1, 3, 4}))
+
+	var sizes []int
+	result := a.Union(b).Then(func(s *Set[int]) {
+		sizes = append(sizes, s.Size())
+	}).Intersect(c).Then(func(s *Set[int]) {
+		sizes = append(sizes, s.Size())
+	}).Difference(NewSetFromSeq(slices.Values([]int{3})))
+
+	assertEquals(t, slices.Equal(sizes, []int{3, 2}), true)
+	assertEquals(t, slices.Equal(result.Members(), []int{1}), true)
+	assertEquals(t, a.Size(), 2)
+	assertEquals(t, b.Size(), 2)
+}
+
+func TestSet_IntersectAndUnionSize(t *testing.T) {
+	s1 := NewSet[int]()
+	s1.Add(1)
+	s1.Add(2)
+	s1.Add(3)
+
+	s2 := NewSet[int]()
+	s2.Add(2)
+	s2.Add(3)
+	s2.Add(4)
+	s2.Add(5)
+
+	intersection, unionSize := s1.IntersectAndUnionSize(s2)
+	assertSameMembers(t, intersection, s1.Intersect(s2))
+	assertEquals(t, unionSize, s1.Union(s2).Size())
+	assertEquals(t, unionSize, 5)
+
+	intersection, unionSize = s2.IntersectAndUnionSize(s1)
+	assertSameMembers(t, intersection, s1.Intersect(s2))
+	assertEquals(t, unionSize, 5)
+
+	intersection, unionSize = s1.IntersectAndUnionSize(s1)
+	assertSameMembers(t, intersection, s1)
+	assertEquals(t, unionSize, 3)
+	intersection.Add(9)
+	assertEquals(t, s1.Contains(9), false)
+
+	empty := NewSet[int]()
+	intersection, unionSize = s1.IntersectAndUnionSize(empty)
+	assertEquals(t, intersection.Size(), 0)
+	assertEquals(t, unionSize, 3)
+}
+
+func TestSet_UnionLazyIntersectLazy(t *testing.T) {
+	s1 := NewSet[int]()
+	s1.Add(1)
+	s1.Add(2)
+	s1.Add(3)
+
+	s2 := NewSet[int]()
+	s2.Add(2)
+	s2.Add(3)
+	s2.Add(4)
+	s2.Add(5)
+
+	union := slices.Collect(s1.UnionLazy(s2))
+	assertEquals(t, len(union), 5)
+	assertSameMembers(t, NewSetFromSeq(slices.Values(union)), s1.Union(s2))
+
+	intersection := slices.Collect(s1.IntersectLazy(s2))
+	assertEquals(t, len(intersection), 2)
+	assertSameMembers(t, NewSetFromSeq(slices.Values(intersection)), s1.Intersect(s2))
+	assertSameMembers(t, NewSetFromSeq(s2.IntersectLazy(s1)), s1.Intersect(s2))
+
+	// Breaking out early stops the iterator
+	count := 0
+	for range s1.UnionLazy(s2) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	assertEquals(t, count, 2)
+
+	// The loop body may mutate the sets
+	for member := range s1.IntersectLazy(s2) {
+		s1.Remove(member)
+	}
+	assertEquals(t, s1.Size(), 1)
+	assertEquals(t, s2.Size(), 4)
+}
+
 func TestSet_Difference(t *testing.T) {
 	s1 := NewSet[int]()
 	s1.Add(1)
@@ -117,6 +687,658 @@ func TestSet_Difference(t *testing.T) {
 	assertEquals(t, slices.Contains(members, 4), false)
 }
 
+func TestSet_Equal(t *testing.T) {
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
+	assertEquals(t, s1.Equal(s2), true)
+	assertEquals(t, s1.Equal(s1), true)
+
+	s1.Add(1)
+	s1.Add(2)
+	s2.Add(2)
+	assertEquals(t, s1.Equal(s2), false)
+	assertEquals(t, s2.Equal(s1), false)
+
+	s2.Add(1)
+	assertEquals(t, s1.Equal(s2), true)
+	assertEquals(t, s2.Equal(s1), true)
+
+	// Same size, different members
+	s2.Remove(1)
+	s2.Add(3)
+	assertEquals(t, s1.Equal(s2), false)
+	assertEquals(t, s2.Equal(s1), false)
+}
+
+func benchmarkEqualSets(differ bool) (*Set[int], *Set[int]) {
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
+	for i := 0; i < 1_000_000; i++ {
+		s1.Add(i)
+		s2.Add(i)
+	}
+	if differ {
+		s2.Remove(500_000)
+		s2.Add(-1)
+	}
+	return s1, s2
+}
+
+func BenchmarkSet_Equal_Equal(b *testing.B) {
+	s1, s2 := benchmarkEqualSets(false)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s1.Equal(s2)
+	}
+}
+
+func BenchmarkSet_Equal_DifferByOne(b *testing.B) {
+	s1, s2 := benchmarkEqualSets(true)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s1.Equal(s2)
+	}
+}
+
+func TestSet_IsProperSubset(t *testing.T) {
+	small := NewSet[int]()
+	small.Add(1)
+	small.Add(2)
+
+	large := NewSet[int]()
+	large.Add(1)
+	large.Add(2)
+	large.Add(3)
+
+	equal := NewSet[int]()
+	equal.Add(1)
+	equal.Add(2)
+
+	other := NewSet[int]()
+	other.Add(1)
+	other.Add(4)
+
+	assertEquals(t, small.IsProperSubset(large), true)
+	assertEquals(t, large.IsProperSuperset(small), true)
+	assertEquals(t, large.IsProperSubset(small), false)
+	assertEquals(t, small.IsProperSuperset(large), false)
+
+	// Equal sets are neither proper subsets nor proper supersets
+	assertEquals(t, small.IsProperSubset(equal), false)
+	assertEquals(t, small.IsProperSuperset(equal), false)
+	assertEquals(t, small.IsProperSubset(small), false)
+	assertEquals(t, small.IsProperSuperset(small), false)
+
+	// A smaller set that is not contained is not a proper subset
+	assertEquals(t, other.IsProperSubset(large), false)
+	assertEquals(t, large.IsProperSuperset(other), false)
+
+	assertEquals(t, NewSet[int]().IsProperSubset(small), true)
+	assertEquals(t, NewSet[int]().IsProperSubset(NewSet[int]()), false)
+}
+
+func TestSet_Merge(t *testing.T) {
+	s1 := NewSet[int]()
+	s1.Add(1)
+	s1.Add(2)
+	s1.Add(3)
+
+	s2 := NewSet[int]()
+	s2.Add(2)
+	s2.Add(3)
+	s2.Add(4)
+	s2.Add(5)
+
+	assertEquals(t, s1.Merge(s2), 2)
+	assertEquals(t, slices.Equal(SortedMembers(s1), []int{1, 2, 3, 4, 5}), true)
+	assertEquals(t, s2.Size(), 4)
+
+	assertEquals(t, s1.Merge(s2), 0)
+	assertEquals(t, s1.Merge(s1), 0)
+	assertEquals(t, s1.Size(), 5)
+}
+
+func TestSet_Subtract(t *testing.T) {
+	s1 := NewSet[int]()
+	s1.Add(1)
+	s1.Add(2)
+	s1.Add(3)
+
+	s2 := NewSet[int]()
+	s2.Add(2)
+	s2.Add(3)
+	s2.Add(4)
+
+	assertEquals(t, s1.Subtract(s2), 2)
+	assertEquals(t, s1.Size(), 1)
+	assertEquals(t, s1.Contains(1), true)
+	assertEquals(t, s2.Size(), 3)
+
+	assertEquals(t, s1.Subtract(s2), 0)
+	assertEquals(t, s1.Subtract(s1), 1)
+	assertEquals(t, s1.Size(), 0)
+}
+
+func TestSet_UpdateOperations(t *testing.T) {
+	newSets := func() (*Set[int], *Set[int]) {
+		s1 := NewSet[int]()
+		s1.Add(1)
+		s1.Add(2)
+		s1.Add(3)
+		s2 := NewSet[int]()
+		s2.Add(2)
+		s2.Add(3)
+		s2.Add(4)
+		return s1, s2
+	}
+	assertOtherUnchanged := func(t *testing.T, s2 *Set[int]) {
+		t.Helper()
+		assertEquals(t, slices.Equal(SortedMembers(s2), []int{2, 3, 4}), true)
+	}
+
+	s1, s2 := newSets()
+	s1.Update(s2)
+	assertEquals(t, slices.Equal(SortedMembers(s1), []int{1, 2, 3, 4}), true)
+	assertOtherUnchanged(t, s2)
+
+	s1, s2 = newSets()
+	s1.DifferenceUpdate(s2)
+	assertEquals(t, slices.Equal(SortedMembers(s1), []int{1}), true)
+	assertOtherUnchanged(t, s2)
+
+	s1, s2 = newSets()
+	s1.IntersectionUpdate(s2)
+	assertEquals(t, slices.Equal(SortedMembers(s1), []int{2, 3}), true)
+	assertOtherUnchanged(t, s2)
+
+	s1, s2 = newSets()
+	s1.SymmetricDifferenceUpdate(s2)
+	assertEquals(t, slices.Equal(SortedMembers(s1), []int{1, 4}), true)
+	assertOtherUnchanged(t, s2)
+
+	// Operating on itself does not deadlock
+	s1, _ = newSets()
+	s1.Update(s1)
+	assertEquals(t, s1.Size(), 3)
+	s1.SymmetricDifferenceUpdate(s1)
+	assertEquals(t, s1.Size(), 0)
+}
+
+func TestSet_MapOperations(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+	set.Add(3)
+
+	raw := map[int]struct{}{2: {}, 3: {}, 4: {}}
+	wrapped := NewSet[int]()
+	for member := range raw {
+		wrapped.Add(member)
+	}
+
+	assertSameMembers(t, set.IntersectMap(raw), set.Intersect(wrapped))
+	assertSameMembers(t, set.UnionMap(raw), set.Union(wrapped))
+	assertSameMembers(t, set.DifferenceMap(raw), set.Difference(wrapped))
+
+	assertEquals(t, set.UnionMap(raw).Size(), 4)
+	assertEquals(t, set.IntersectMap(nil).Size(), 0)
+	assertEquals(t, set.DifferenceMap(nil).Size(), 3)
+	assertEquals(t, set.Size(), 3)
+	assertEquals(t, len(raw), 3)
+}
+
+func TestSet_Diff(t *testing.T) {
+	s1 := NewSet[int]()
+	s1.Add(1)
+	s1.Add(2)
+	s1.Add(3)
+
+	s2 := NewSet[int]()
+	s2.Add(2)
+	s2.Add(3)
+	s2.Add(4)
+	s2.Add(5)
+
+	onlyInS1, onlyInS2 := s1.Diff(s2)
+
+	assertSameMembers(t, onlyInS1, s1.Difference(s2))
+	assertSameMembers(t, onlyInS2, s2.Difference(s1))
+	assertEquals(t, onlyInS1.Size(), 1)
+	assertEquals(t, onlyInS1.Contains(1), true)
+	assertEquals(t, onlyInS2.Size(), 2)
+	assertEquals(t, onlyInS2.Contains(4), true)
+	assertEquals(t, onlyInS2.Contains(5), true)
+
+	onlyInS1, onlyInS2 = s1.Diff(s1)
+	assertEquals(t, onlyInS1.Size(), 0)
+	assertEquals(t, onlyInS2.Size(), 0)
+	assertEquals(t, len(s1.DiffEvents(s1)), 0)
+}
+
+func TestSet_DiffEvents(t *testing.T) {
+	oldState := NewSet[int]()
+	oldState.Add(1)
+	oldState.Add(2)
+	oldState.Add(3)
+
+	newState := NewSet[int]()
+	newState.Add(2)
+	newState.Add(3)
+	newState.Add(4)
+	newState.Add(5)
+
+	events := oldState.DiffEvents(newState)
+	assertEquals(t, len(events), 3)
+	assertEquals(t, events[0], ChangeEvent[int]{Kind: ChangeRemove, Member: 1})
+	assertEquals(t, events[1].Kind, ChangeAdd)
+	assertEquals(t, events[2].Kind, ChangeAdd)
+
+	replayed := oldState.Union(NewSet[int]())
+	for _, event := range events {
+		switch event.Kind {
+		case ChangeAdd:
+			replayed.Add(event.Member)
+		case ChangeRemove:
+			replayed.Remove(event.Member)
+		}
+	}
+	assertEquals(t, replayed.Equal(newState), true)
+	assertEquals(t, oldState.Size(), 3)
+
+	assertEquals(t, len(oldState.DiffEvents(oldState.Union(NewSet[int]()))), 0)
+}
+
+func TestSet_PartitionBy(t *testing.T) {
+	set := NewSet[int]()
+	for i := -3; i < 10; i++ {
+		set.Add(i)
+	}
+
+	partitions := set.PartitionBy(3, func(v int) int { return v })
+	assertEquals(t, len(partitions), 3)
+	assertEquals(t, partitions[0].Size()+partitions[1].Size()+partitions[2].Size(), set.Size())
+	assertSameMembers(t, partitions[0], NewSetFromSeq(slices.Values([]int{-3, 0, 3, 6, 9})))
+	assertSameMembers(t, partitions[1], NewSetFromSeq(slices.Values([]int{-2, 1, 4, 7})))
+	assertSameMembers(t, partitions[2], NewSetFromSeq(slices.Values([]int{-1, 2, 5, 8})))
+
+	single := set.PartitionBy(1, func(v int) int { return v })
+	assertSameMembers(t, single[0], set)
+
+	assertEquals(t, set.PartitionBy(0, func(v int) int { return v }) == nil, true)
+	assertEquals(t, set.Size(), 13)
+}
+
+func TestSet_SubsetOfSlice(t *testing.T) {
+	set := NewSet[string]()
+	assertEquals(t, set.SubsetOfSlice([]string{}), true)
+
+	set.Add("read")
+	set.Add("write")
+
+	assertEquals(t, set.SubsetOfSlice([]string{"read", "write", "admin"}), true)
+	assertEquals(t, set.SubsetOfSlice([]string{"write", "read"}), true)
+	assertEquals(t, set.SubsetOfSlice([]string{"read", "admin"}), false)
+	assertEquals(t, set.SubsetOfSlice(nil), false)
+}
+
+func TestSet_SupersetOfSlice(t *testing.T) {
+	set := NewSet[string]()
+	set.Add("read")
+	set.Add("write")
+
+	assertEquals(t, set.SupersetOfSlice([]string{"read"}), true)
+	assertEquals(t, set.SupersetOfSlice([]string{"read", "write", "read"}), true)
+	assertEquals(t, set.SupersetOfSlice(nil), true)
+	assertEquals(t, set.SupersetOfSlice([]string{"read", "admin"}), false)
+}
+
+func TestSet_FilterSlice(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(3)
+	set.Add(5)
+
+	assertEquals(t, slices.Equal(set.FilterSlice([]int{5, 4, 3, 2, 1}), []int{5, 3, 1}), true)
+	assertEquals(t, slices.Equal(set.FilterSlice([]int{3, 3, 2, 1, 3}), []int{3, 3, 1, 3}), true)
+	assertEquals(t, len(set.FilterSlice([]int{2, 4})), 0)
+	assertEquals(t, len(set.FilterSlice(nil)), 0)
+}
+
+func TestSet_CloneFiltered(t *testing.T) {
+	set := NewSet[int]()
+	for i := 1; i <= 6; i++ {
+		set.Add(i)
+	}
+
+	evens := set.CloneFiltered(func(v int) bool { return v%2 == 0 })
+	assertSameMembers(t, evens, NewSetFromSeq(slices.Values([]int{2, 4, 6})))
+
+	all := set.CloneFiltered(nil)
+	assertSameMembers(t, all, set)
+
+	// Clones are independent of the source
+	all.Add(7)
+	evens.Remove(2)
+	set.Remove(4)
+	assertEquals(t, set.Contains(7), false)
+	assertEquals(t, set.Contains(2), true)
+	assertEquals(t, evens.Contains(4), true)
+	assertEquals(t, all.Contains(4), true)
+
+	ordered := NewSet[string](WithInsertionOrder())
+	ordered.Add("c")
+	ordered.Add("a")
+	ordered.Add("b")
+	clone := ordered.CloneFiltered(func(v string) bool { return v != "a" })
+	clone.Add("d")
+	assertEquals(t, slices.Equal(clone.Members(), []string{"c", "b", "d"}), true)
+}
+
+func TestSet_FilterInto(t *testing.T) {
+	set := NewSet[int]()
+	for i := 1; i <= 6; i++ {
+		set.Add(i)
+	}
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	dst := NewSet[int]()
+	dst.Add(1)
+	dst.Add(100)
+	set.FilterInto(dst, isEven)
+	assertSameMembers(t, dst, NewSetFromSeq(slices.Values([]int{2, 4, 6})))
+	assertEquals(t, set.Size(), 6)
+
+	// Reusing the destination replaces its previous contents
+	set.FilterInto(dst, func(v int) bool { return v > 4 })
+	assertSameMembers(t, dst, NewSetFromSeq(slices.Values([]int{5, 6})))
+
+	set.FilterInto(dst, func(v int) bool { return false })
+	assertEquals(t, dst.Size(), 0)
+
+	// Filtering into the source filters in place
+	set.FilterInto(set, isEven)
+	assertSameMembers(t, set, NewSetFromSeq(slices.Values([]int{2, 4, 6})))
+}
+
+func TestSet_Stream(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+	set.Add(3)
+
+	received := make([]int, 0)
+	for member := range set.Stream(context.Background()) {
+		received = append(received, member)
+	}
+
+	assertEquals(t, len(received), 3)
+	assertEquals(t, slices.Contains(received, 1), true)
+	assertEquals(t, slices.Contains(received, 2), true)
+	assertEquals(t, slices.Contains(received, 3), true)
+}
+
+func TestSet_Stream_Cancel(t *testing.T) {
+	set := NewSet[int]()
+	for i := 0; i < 100; i++ {
+		set.Add(i)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := set.Stream(ctx)
+
+	_, ok := <-ch
+	assertEquals(t, ok, true)
+	cancel()
+
+	// The channel must be closed once the producer notices the cancellation.
+	// At most one further value may already have been in flight.
+	received := 0
+	for range ch {
+		received++
+	}
+	if received > 1 {
+		t.Errorf("received %d values after cancel, want at most 1", received)
+	}
+}
+
+func TestSet_IntersectAll(t *testing.T) {
+	assertEquals(t, IntersectAll[int]().Size(), 0)
+
+	s1 := NewSet[int]()
+	s1.Add(1)
+	s1.Add(2)
+	s1.Add(3)
+
+	s2 := NewSet[int]()
+	s2.Add(2)
+	s2.Add(3)
+	s2.Add(4)
+
+	s3 := NewSet[int]()
+	s3.Add(3)
+	s3.Add(2)
+	s3.Add(5)
+
+	result := IntersectAll(s1, s2, s3)
+	assertSameMembers(t, result, s1.Intersect(s2).Intersect(s3))
+	assertEquals(t, result.Size(), 2)
+
+	assertSameMembers(t, IntersectAll(s1), s1)
+	result.Add(9)
+	assertEquals(t, s1.Contains(9), false)
+}
+
+func TestSet_IntersectAll_ShortCircuit(t *testing.T) {
+	s1 := NewSet[int]()
+	s1.Add(1)
+	s1.Add(2)
+
+	disjoint := NewSet[int]()
+	disjoint.Add(3)
+
+	// A nil set panics if examined, acting as a spy that proves the later sets are skipped
+	var spy *Set[int]
+
+	result := IntersectAll(s1, disjoint, spy, spy)
+	assertEquals(t, result.Size(), 0)
+
+	result = IntersectAll(NewSet[int](), spy)
+	assertEquals(t, result.Size(), 0)
+}
+
+func TestSet_CountDistinct(t *testing.T) {
+	assertEquals(t, CountDistinct[int](), 0)
+
+	s1 := NewSet[int]()
+	s1.Add(1)
+	s1.Add(2)
+	s1.Add(3)
+
+	s2 := NewSet[int]()
+	s2.Add(2)
+	s2.Add(3)
+	s2.Add(4)
+
+	s3 := NewSet[int]()
+	s3.Add(4)
+	s3.Add(5)
+
+	empty := NewSet[int]()
+
+	assertEquals(t, CountDistinct(s1), s1.Size())
+	assertEquals(t, CountDistinct(s1, s2), s1.Union(s2).Size())
+	assertEquals(t, CountDistinct(s1, s2, s3), s1.Union(s2).Union(s3).Size())
+	assertEquals(t, CountDistinct(s1, s2, s3, empty, s1), 5)
+}
+
+func TestSet_TransformKeys(t *testing.T) {
+	set := NewSet[string]()
+	set.Add("A")
+	set.Add("a")
+	set.Add("B")
+	set.Add("c")
+
+	result, collisions := TransformKeys(set, strings.ToLower)
+
+	assertEquals(t, collisions, 1)
+	assertEquals(t, result.Size(), 3)
+	assertEquals(t, result.Contains("a"), true)
+	assertEquals(t, result.Contains("b"), true)
+	assertEquals(t, result.Contains("c"), true)
+	assertEquals(t, set.Size(), 4)
+
+	result, collisions = TransformKeys(set, strings.ToUpper)
+	assertEquals(t, collisions, 1)
+	assertEquals(t, result.Size(), 3)
+
+	result, collisions = TransformKeys(set, func(v string) string { return v + "!" })
+	assertEquals(t, collisions, 0)
+	assertEquals(t, result.Size(), 4)
+}
+
+func TestSet_EqualNormalized(t *testing.T) {
+	a := NewSetFromSeq(slices.Values([]string{"A", "b"}))
+	b := NewSetFromSeq(slices.Values([]string{"a", "B"}))
+
+	assertEquals(t, EqualNormalized(a, b, strings.ToLower), true)
+	assertEquals(t, EqualNormalized(a, b, func(v string) string { return v }), false)
+	assertEquals(t, a.Equal(b), false)
+
+	c := NewSetFromSeq(slices.Values([]string{"a", "C"}))
+	assertEquals(t, EqualNormalized(a, c, strings.ToLower), false)
+
+	// Members that collapse under norm count once
+	d := NewSetFromSeq(slices.Values([]string{"a", "A", "b"}))
+	assertEquals(t, EqualNormalized(a, d, strings.ToLower), true)
+
+	assertEquals(t, EqualNormalized(NewSet[string](), NewSet[string](), strings.ToLower), true)
+}
+
+func TestSet_GroupBy(t *testing.T) {
+	set := NewSetFromSeq(slices.Values([]string{"apple", "avocado", "banana", "blueberry", "cherry"}))
+
+	groups := GroupBy(set, func(v string) byte { return v[0] })
+	assertEquals(t, len(groups), 3)
+	assertSameMembers(t, groups['a'], NewSetFromSeq(slices.Values([]string{"apple", "avocado"})))
+	assertSameMembers(t, groups['b'], NewSetFromSeq(slices.Values([]string{"banana", "blueberry"})))
+	assertSameMembers(t, groups['c'], NewSetFromSeq(slices.Values([]string{"cherry"})))
+	assertEquals(t, groups['d'] == nil, true)
+	assertEquals(t, set.Size(), 5)
+
+	assertEquals(t, len(GroupBy(NewSet[string](), func(v string) int { return len(v) })), 0)
+}
+
+func TestSet_MapToSlice(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1)
+	set.Add(2)
+	set.Add(-2)
+
+	labels := MapToSlice(set, strconv.Itoa)
+	assertEquals(t, len(labels), set.Size())
+	slices.Sort(labels)
+	assertEquals(t, slices.Equal(labels, []string{"-2", "1", "2"}), true)
+
+	// Duplicate results are kept
+	squares := MapToSlice(set, func(v int) int { return v * v })
+	slices.Sort(squares)
+	assertEquals(t, slices.Equal(squares, []int{1, 4, 4}), true)
+
+	assertEquals(t, len(MapToSlice(NewSet[int](), strconv.Itoa)), 0)
+}
+
+func TestSet_MinMax(t *testing.T) {
+	ints := NewSet[int]()
+	_, ok := Min(ints)
+	assertEquals(t, ok, false)
+	_, ok = Max(ints)
+	assertEquals(t, ok, false)
+
+	ints.Add(5)
+	ints.Add(-2)
+	ints.Add(9)
+	ints.Add(3)
+
+	minInt, ok := Min(ints)
+	assertEquals(t, ok, true)
+	assertEquals(t, minInt, -2)
+	maxInt, ok := Max(ints)
+	assertEquals(t, ok, true)
+	assertEquals(t, maxInt, 9)
+
+	strs := NewSet[string]()
+	strs.Add("pear")
+	strs.Add("apple")
+	strs.Add("zucchini")
+
+	minStr, ok := Min(strs)
+	assertEquals(t, ok, true)
+	assertEquals(t, minStr, "apple")
+	maxStr, ok := Max(strs)
+	assertEquals(t, ok, true)
+	assertEquals(t, maxStr, "zucchini")
+}
+
+func TestSet_SumAverage(t *testing.T) {
+	ints := NewSet[int]()
+	assertEquals(t, Sum(ints), 0)
+	assertEquals(t, Average(ints), 0.0)
+
+	ints.Add(1)
+	ints.Add(2)
+	ints.Add(3)
+	ints.Add(4)
+	assertEquals(t, Sum(ints), 10)
+	assertEquals(t, Average(ints), 2.5)
+
+	floats := NewSet[float64]()
+	floats.Add(0.5)
+	floats.Add(1.5)
+	floats.Add(-2.5)
+	assertEquals(t, Sum(floats), -0.5)
+	assertEquals(t, Average(floats), -0.5/3)
+
+	bytes := NewSet[uint8]()
+	bytes.Add(200)
+	bytes.Add(100)
+	// The average is computed in float64, so it doesn't overflow like the uint8 sum
+	assertEquals(t, Average(bytes), 150.0)
+}
+
+func TestSet_SortedMembers(t *testing.T) {
+	ints := NewSet[int]()
+	assertEquals(t, len(SortedMembers(ints)), 0)
+
+	ints.Add(3)
+	ints.Add(-1)
+	ints.Add(10)
+	ints.Add(2)
+	assertEquals(t, slices.Equal(SortedMembers(ints), []int{-1, 2, 3, 10}), true)
+	assertEquals(t, ints.Size(), 4)
+
+	strs := NewSet[string]()
+	strs.Add("pear")
+	strs.Add("apple")
+	strs.Add("fig")
+	assertEquals(t, slices.Equal(SortedMembers(strs), []string{"apple", "fig", "pear"}), true)
+	assertEquals(t, strs.Size(), 3)
+	assertEquals(t, strs.Contains("pear"), true)
+}
+
+func assertSameMembers[T comparable](t *testing.T, got, want *Set[T]) {
+	t.Helper()
+	if got.Size() != want.Size() {
+		t.Errorf("got %v, want %v", got.Members(), want.Members())
+		return
+	}
+	for _, member := range want.Members() {
+		if !got.Contains(member) {
+			t.Errorf("got %v, want %v", got.Members(), want.Members())
+			return
+		}
+	}
+}
+
 func assertEquals[V comparable](t *testing.T, got, want V) {
 	t.Helper()
 	if got != want {
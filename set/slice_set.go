@@ -0,0 +1,152 @@
+package set
+
+import (
+	"slices"
+	"sync"
+)
+
+// EqualFunc returns true if a and b have the same length and eq reports every pair of
+// corresponding elements as equal, false otherwise. It is a convenience for building the equals
+// function of a SliceSet whose elements are slices.
+//
+// Example:
+//
+//	a := []string{"A", "b"}
+//	b := []string{"a", "B"}
+//	fmt.Println(EqualFunc(a, b, strings.EqualFold)) // Output: true
+func EqualFunc[T any](a, b []T, eq func(T, T) bool) bool {
+	return slices.EqualFunc(a, b, eq)
+}
+
+// SliceSet represents a thread-safe collection of unique elements of any type, including types
+// that cannot be map keys such as slices. Elements are bucketed by a user-supplied hash and told
+// apart within a bucket by a user-supplied equals function, which must agree: elements that are
+// equal must have the same hash. Lookups are O(1) on average, degrading with hash collisions.
+// The zero value is not usable; use NewSliceSet to create a new SliceSet.
+type SliceSet[T any] struct {
+	buckets map[uint64][]T
+	size    int
+	hash    func(T) uint64
+	equals  func(a, b T) bool
+	mu      sync.RWMutex
+}
+
+// NewSliceSet creates and initializes a new empty SliceSet that identifies elements by hash and equals.
+//
+// Example:
+//
+//	s := NewSliceSet(
+//		func(v []int) uint64 { return hashInts(v) },
+//		func(a, b []int) bool { return slices.Equal(a, b) },
+//	)
+//	s.Add([]int{1, 2})
+func NewSliceSet[T any](hash func(T) uint64, equals func(a, b T) bool) *SliceSet[T] {
+	return &SliceSet[T]{
+		buckets: make(map[uint64][]T),
+		hash:    hash,
+		equals:  equals,
+	}
+}
+
+// Add inserts an element into the SliceSet and reports whether it was added.
+// Returns false, leaving the SliceSet unchanged, if an equal element already exists.
+// The SliceSet keeps a reference to the element, so slice elements should not be modified afterwards.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSliceSet(hashInts, slices.Equal[[]int])
+//	fmt.Println(s.Add([]int{1, 2})) // Output: true
+//	fmt.Println(s.Add([]int{1, 2})) // Output: false
+func (s *SliceSet[T]) Add(member T) bool {
+	h := s.hash(member)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.indexIn(s.buckets[h], member) != -1 {
+		return false
+	}
+	s.buckets[h] = append(s.buckets[h], member)
+	s.size++
+	return true
+}
+
+// Remove deletes the element equal to member from the SliceSet and reports whether it was present.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSliceSet(hashInts, slices.Equal[[]int])
+//	s.Add([]int{1, 2})
+//	fmt.Println(s.Remove([]int{1, 2})) // Output: true
+func (s *SliceSet[T]) Remove(member T) bool {
+	h := s.hash(member)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bucket := s.buckets[h]
+	i := s.indexIn(bucket, member)
+	if i == -1 {
+		return false
+	}
+	if len(bucket) == 1 {
+		delete(s.buckets, h)
+	} else {
+		s.buckets[h] = slices.Delete(bucket, i, i+1)
+	}
+	s.size--
+	return true
+}
+
+// Contains returns true if an element equal to member exists in the SliceSet, false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSliceSet(hashInts, slices.Equal[[]int])
+//	s.Add([]int{1, 2})
+//	fmt.Println(s.Contains([]int{1, 2})) // Output: true
+func (s *SliceSet[T]) Contains(member T) bool {
+	h := s.hash(member)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.indexIn(s.buckets[h], member) != -1
+}
+
+// Size returns the number of elements in the SliceSet.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSliceSet(hashInts, slices.Equal[[]int])
+//	s.Add([]int{1, 2})
+//	fmt.Println(s.Size()) // Output: 1
+func (s *SliceSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.size
+}
+
+// Members returns a slice containing all elements in the SliceSet.
+// The order of elements is not guaranteed to be stable between calls.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSliceSet(hashInts, slices.Equal[[]int])
+//	s.Add([]int{1, 2})
+//	fmt.Println(s.Members()) // Output: [[1 2]]
+func (s *SliceSet[T]) Members() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	members := make([]T, 0, s.size)
+	for _, bucket := range s.buckets {
+		members = append(members, bucket...)
+	}
+	return members
+}
+
+// indexIn returns the position of the element equal to member in bucket, or -1 if there is none.
+func (s *SliceSet[T]) indexIn(bucket []T, member T) int {
+	return slices.IndexFunc(bucket, func(e T) bool {
+		return s.equals(e, member)
+	})
+}
@@ -0,0 +1,73 @@
+package set
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestEqualFunc(t *testing.T) {
+	assertEquals(t, EqualFunc([]string{"A", "b"}, []string{"a", "B"}, strings.EqualFold), true)
+	assertEquals(t, EqualFunc([]string{"A", "b"}, []string{"a", "C"}, strings.EqualFold), false)
+	assertEquals(t, EqualFunc([]string{"a"}, []string{"a", "a"}, strings.EqualFold), false)
+	assertEquals(t, EqualFunc([]string{}, nil, strings.EqualFold), true)
+}
+
+func TestSliceSet(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashInts := func(v []int) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		var buf [8]byte
+		for _, n := range v {
+			binary.LittleEndian.PutUint64(buf[:], uint64(n))
+			h.Write(buf[:])
+		}
+		return h.Sum64()
+	}
+	equalInts := func(a, b []int) bool {
+		return EqualFunc(a, b, func(x, y int) bool { return x == y })
+	}
+
+	set := NewSliceSet(hashInts, equalInts)
+	assertEquals(t, set.Size(), 0)
+
+	assertEquals(t, set.Add([]int{1, 2}), true)
+	assertEquals(t, set.Add([]int{2, 1}), true)
+	assertEquals(t, set.Add([]int{1, 2}), false)
+	assertEquals(t, set.Add(nil), true)
+	assertEquals(t, set.Add([]int{}), false)
+	assertEquals(t, set.Size(), 3)
+
+	assertEquals(t, set.Contains([]int{1, 2}), true)
+	assertEquals(t, set.Contains([]int{1, 2, 3}), false)
+
+	assertEquals(t, set.Remove([]int{1, 2}), true)
+	assertEquals(t, set.Remove([]int{1, 2}), false)
+	assertEquals(t, set.Size(), 2)
+	assertEquals(t, len(set.Members()), 2)
+}
+
+func TestSliceSet_Collisions(t *testing.T) {
+	// A constant hash forces every element into one bucket
+	set := NewSliceSet(func(v []string) uint64 { return 0 }, func(a, b []string) bool {
+		return EqualFunc(a, b, strings.EqualFold)
+	})
+
+	set.Add([]string{"a"})
+	set.Add([]string{"b"})
+	set.Add([]string{"A"})
+	set.Add([]string{"c"})
+	assertEquals(t, set.Size(), 3)
+
+	set.Remove([]string{"B"})
+	assertEquals(t, set.Contains([]string{"b"}), false)
+	assertEquals(t, set.Contains([]string{"C"}), true)
+
+	members := set.Members()
+	firsts := []string{members[0][0], members[1][0]}
+	slices.Sort(firsts)
+	assertEquals(t, slices.Equal(firsts, []string{"a", "c"}), true)
+}
@@ -0,0 +1,230 @@
+package set
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+// StringSet represents a thread-safe collection of unique strings backed by a trie,
+// supporting prefix queries in addition to the usual set operations.
+// The zero value is not usable; use NewStringSet to create a new StringSet.
+type StringSet struct {
+	root *trieNode
+	size int
+	mu   sync.RWMutex
+}
+
+type trieNode struct {
+	children map[byte]*trieNode
+	terminal bool
+}
+
+func newTrieNode() *trieNode {
+	return &trieNode{children: make(map[byte]*trieNode)}
+}
+
+// NewStringSet creates and initializes a new empty StringSet.
+//
+// Example:
+//
+//	s := NewStringSet()
+//	s.Add("foo")
+func NewStringSet() *StringSet {
+	return &StringSet{
+		root: newTrieNode(),
+	}
+}
+
+// Add inserts a string into the StringSet.
+// If the string already exists, the StringSet remains unchanged.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStringSet()
+//	s.Add("foo") // Set now contains "foo"
+//	s.Add("foo") // Set still contains just "foo"
+func (s *StringSet) Add(member string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	node := s.root
+	for i := 0; i < len(member); i++ {
+		child, ok := node.children[member[i]]
+		if !ok {
+			child = newTrieNode()
+			node.children[member[i]] = child
+		}
+		node = child
+	}
+	if !node.terminal {
+		node.terminal = true
+		s.size++
+	}
+}
+
+// Remove deletes a string from the StringSet, pruning trie branches that no longer lead to a member.
+// If the string doesn't exist, the StringSet remains unchanged.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStringSet()
+//	s.Add("foo")
+//	s.Remove("foo") // Set is now empty
+func (s *StringSet) Remove(member string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	path := make([]*trieNode, 0, len(member)+1)
+	node := s.root
+	path = append(path, node)
+	for i := 0; i < len(member); i++ {
+		child, ok := node.children[member[i]]
+		if !ok {
+			return
+		}
+		node = child
+		path = append(path, node)
+	}
+	if !node.terminal {
+		return
+	}
+	node.terminal = false
+	s.size--
+
+	// Walk back up, detaching nodes that are neither members nor lead to one
+	for i := len(member); i > 0; i-- {
+		current := path[i]
+		if current.terminal || len(current.children) > 0 {
+			break
+		}
+		delete(path[i-1].children, member[i-1])
+	}
+}
+
+// Contains returns true if the string exists in the StringSet, false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStringSet()
+//	s.Add("foo")
+//	fmt.Println(s.Contains("foo")) // Output: true
+//	fmt.Println(s.Contains("fo"))  // Output: false
+func (s *StringSet) Contains(member string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	node := s.find(member)
+	return node != nil && node.terminal
+}
+
+// Size returns the number of strings in the StringSet.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStringSet()
+//	s.Add("foo")
+//	fmt.Println(s.Size()) // Output: 1
+func (s *StringSet) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.size
+}
+
+// Members returns a slice containing all strings in the StringSet, in byte-wise lexical order.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStringSet()
+//	s.Add("b")
+//	s.Add("a")
+//	fmt.Println(s.Members()) // Output: [a b]
+func (s *StringSet) Members() []string {
+	return s.MembersWithPrefix("")
+}
+
+// MembersWithPrefix returns all strings in the StringSet that start with prefix,
+// in byte-wise lexical order.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStringSet()
+//	s.Add("car")
+//	s.Add("cart")
+//	s.Add("dog")
+//	fmt.Println(s.MembersWithPrefix("car")) // Output: [car cart]
+func (s *StringSet) MembersWithPrefix(prefix string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	members := make([]string, 0)
+	node := s.find(prefix)
+	if node == nil {
+		return members
+	}
+	buf := []byte(prefix)
+	collectMembers(node, &buf, &members)
+	return members
+}
+
+// LongestPrefixOf returns the longest member of the StringSet that is a prefix of str.
+// Returns the member and true if one exists, or an empty string and false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStringSet()
+//	s.Add("/api")
+//	s.Add("/api/users")
+//	prefix, ok := s.LongestPrefixOf("/api/users/42") // prefix = "/api/users", ok = true
+func (s *StringSet) LongestPrefixOf(str string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	node := s.root
+	longest := -1
+	if node.terminal {
+		longest = 0
+	}
+	for i := 0; i < len(str); i++ {
+		child, ok := node.children[str[i]]
+		if !ok {
+			break
+		}
+		node = child
+		if node.terminal {
+			longest = i + 1
+		}
+	}
+	if longest == -1 {
+		return "", false
+	}
+	return str[:longest], true
+}
+
+// find returns the node reached by following prefix from the root, or nil if there is none.
+func (s *StringSet) find(prefix string) *trieNode {
+	node := s.root
+	for i := 0; i < len(prefix); i++ {
+		child, ok := node.children[prefix[i]]
+		if !ok {
+			return nil
+		}
+		node = child
+	}
+	return node
+}
+
+// collectMembers appends every member below node, in lexical order, to members.
+// buf holds the bytes of the path to node and is restored before returning.
+func collectMembers(node *trieNode, buf *[]byte, members *[]string) {
+	if node.terminal {
+		*members = append(*members, string(*buf))
+	}
+	for _, b := range slices.Sorted(maps.Keys(node.children)) {
+		*buf = append(*buf, b)
+		collectMembers(node.children[b], buf, members)
+		*buf = (*buf)[:len(*buf)-1]
+	}
+}
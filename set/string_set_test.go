@@ -0,0 +1,93 @@
+package set
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStringSet_AddRemoveContains(t *testing.T) {
+	set := NewStringSet()
+	assertEquals(t, set.Size(), 0)
+
+	set.Add("car")
+	set.Add("cart")
+	set.Add("car")
+	assertEquals(t, set.Size(), 2)
+	assertEquals(t, set.Contains("car"), true)
+	assertEquals(t, set.Contains("cart"), true)
+	assertEquals(t, set.Contains("ca"), false)
+	assertEquals(t, set.Contains("carts"), false)
+
+	set.Remove("ca")
+	assertEquals(t, set.Size(), 2)
+
+	set.Remove("car")
+	assertEquals(t, set.Size(), 1)
+	assertEquals(t, set.Contains("car"), false)
+	assertEquals(t, set.Contains("cart"), true)
+
+	set.Add("")
+	assertEquals(t, set.Contains(""), true)
+	assertEquals(t, set.Size(), 2)
+}
+
+func TestStringSet_MembersWithPrefix(t *testing.T) {
+	set := NewStringSet()
+	set.Add("dog")
+	set.Add("cart")
+	set.Add("car")
+	set.Add("cat")
+	set.Add("carbon")
+
+	assertEquals(t, fmt.Sprint(set.Members()), "[car carbon cart cat dog]")
+	assertEquals(t, fmt.Sprint(set.MembersWithPrefix("car")), "[car carbon cart]")
+	assertEquals(t, fmt.Sprint(set.MembersWithPrefix("ca")), "[car carbon cart cat]")
+	assertEquals(t, fmt.Sprint(set.MembersWithPrefix("cartography")), "[]")
+	assertEquals(t, fmt.Sprint(set.MembersWithPrefix("x")), "[]")
+}
+
+func TestStringSet_LongestPrefixOf(t *testing.T) {
+	set := NewStringSet()
+	set.Add("/api")
+	set.Add("/api/users")
+
+	prefix, ok := set.LongestPrefixOf("/api/users/42")
+	assertEquals(t, ok, true)
+	assertEquals(t, prefix, "/api/users")
+
+	prefix, ok = set.LongestPrefixOf("/api/orders")
+	assertEquals(t, ok, true)
+	assertEquals(t, prefix, "/api")
+
+	prefix, ok = set.LongestPrefixOf("/api")
+	assertEquals(t, ok, true)
+	assertEquals(t, prefix, "/api")
+
+	prefix, ok = set.LongestPrefixOf("/ap")
+	assertEquals(t, ok, false)
+	assertEquals(t, prefix, "")
+
+	set.Add("")
+	prefix, ok = set.LongestPrefixOf("/static")
+	assertEquals(t, ok, true)
+	assertEquals(t, prefix, "")
+}
+
+func TestStringSet_RemovePrunes(t *testing.T) {
+	set := NewStringSet()
+	set.Add("car")
+	set.Add("cart")
+	set.Add("dog")
+
+	set.Remove("cart")
+	// "car" is still a member, so only the "t" branch is pruned
+	assertEquals(t, len(set.find("car").children), 0)
+
+	set.Remove("car")
+	assertEquals(t, set.find("c") == nil, true)
+	assertEquals(t, len(set.root.children), 1)
+
+	set.Remove("dog")
+	assertEquals(t, len(set.root.children), 0)
+	assertEquals(t, set.Size(), 0)
+}
@@ -0,0 +1,120 @@
+package set
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// ErrDanglingEscape is returned by TextSet.UnmarshalText when the text ends with an unescaped
+// backslash.
+var ErrDanglingEscape = errors.New("text ends with a dangling escape")
+
+// TextSet wraps a Set of strings (including named string types) so it can be used wherever a text
+// encoding is expected, such as JSON, YAML or TOML values and map keys. The methods live on this
+// type rather than on Set so that sets of other element types do not satisfy
+// encoding.TextMarshaler and keep their usual encoding.
+//
+// Members are written as a comma-separated list, sorted for stable output. Commas and backslashes
+// inside members are escaped with a backslash, so "a,b" is written as `a\,b` and `c\d` as `c\\d`.
+// Empty strings and leading or trailing whitespace are not preserved by UnmarshalText.
+//
+// Example:
+//
+//	var config struct {
+//		Tags TextSet[string] `json:"tags"`
+//	}
+//	json.Unmarshal([]byte(`{"tags":"a,b"}`), &config) // config.Tags contains "a" and "b"
+type TextSet[T ~string] struct {
+	*Set[T]
+}
+
+// MarshalText encodes the set as a comma-separated list of its members. A TextSet without a Set is
+// encoded as empty text.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewSet[string]()
+//	s.Add("b")
+//	s.Add("a")
+//	text, _ := TextSet[string]{s}.MarshalText() // text = "a,b"
+func (t TextSet[T]) MarshalText() ([]byte, error) {
+	if t.Set == nil {
+		return []byte{}, nil
+	}
+	members := t.Members()
+	encoded := make([]string, 0, len(members))
+	for _, member := range members {
+		encoded = append(encoded, escapeText(string(member)))
+	}
+	slices.Sort(encoded)
+	return []byte(strings.Join(encoded, ",")), nil
+}
+
+// UnmarshalText replaces the contents of the set with the members of a comma-separated list, in
+// the format written by MarshalText. Each member is unescaped and trimmed of surrounding
+// whitespace; empty members are skipped. If the set tracks insertion order, members are ordered as
+// they appear in text. A TextSet without a Set is given a new one. If text ends with an unescaped
+// backslash, ErrDanglingEscape is returned and the set is left unchanged.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	t := TextSet[string]{NewSet[string]()}
+//	err := t.UnmarshalText([]byte("a, b ,c")) // t now contains "a", "b" and "c"
+func (t *TextSet[T]) UnmarshalText(text []byte) error {
+	fields, err := splitText(string(text))
+	if err != nil {
+		return err
+	}
+	members := make(map[T]struct{}, len(fields))
+	order := make([]T, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		members[T(field)] = struct{}{}
+		order = append(order, T(field))
+	}
+
+	if t.Set == nil {
+		t.Set = NewSet[T]()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset(members, order)
+	return nil
+}
+
+// escapeText escapes backslashes and commas so the member survives splitText.
+func escapeText(member string) string {
+	member = strings.ReplaceAll(member, `\`, `\\`)
+	return strings.ReplaceAll(member, ",", `\,`)
+}
+
+// splitText splits text on unescaped commas, unescaping each field.
+func splitText(text string) ([]string, error) {
+	fields := make([]string, 0)
+	var field strings.Builder
+	escaped := false
+	for _, r := range text {
+		switch {
+		case escaped:
+			field.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteRune(r)
+		}
+	}
+	if escaped {
+		return nil, ErrDanglingEscape
+	}
+	return append(fields, field.String()), nil
+}
@@ -0,0 +1,119 @@
+package set
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTextSet_MarshalText(t *testing.T) {
+	set := NewSet[string]()
+	text, err := TextSet[string]{set}.MarshalText()
+	assertEquals(t, err, nil)
+	assertEquals(t, string(text), "")
+
+	set.Add("b")
+	set.Add("a")
+	set.Add("c,d")
+	set.Add(`e\f`)
+
+	text, err = TextSet[string]{set}.MarshalText()
+	assertEquals(t, err, nil)
+	assertEquals(t, string(text), `a,b,c\,d,e\\f`)
+
+	text, err = TextSet[string]{}.MarshalText()
+	assertEquals(t, err, nil)
+	assertEquals(t, string(text), "")
+
+	// Only TextSet implements the text interfaces, so encoders leave plain sets alone
+	_, ok := any(NewSet[string]()).(encoding.TextMarshaler)
+	assertEquals(t, ok, false)
+	_, ok = any(NewSet[any]()).(encoding.TextUnmarshaler)
+	assertEquals(t, ok, false)
+	_, ok = any(&TextSet[string]{}).(encoding.TextUnmarshaler)
+	assertEquals(t, ok, true)
+}
+
+func TestTextSet_UnmarshalText(t *testing.T) {
+	set := NewSet[string]()
+	set.Add("stale")
+	text := TextSet[string]{set}
+
+	assertEquals(t, text.UnmarshalText([]byte(" a , b,,c\\,d ,  ")), nil)
+	assertEquals(t, set.Size(), 3)
+	assertEquals(t, set.Contains("a"), true)
+	assertEquals(t, set.Contains("b"), true)
+	assertEquals(t, set.Contains("c,d"), true)
+	assertEquals(t, set.Contains("stale"), false)
+
+	assertEquals(t, text.UnmarshalText([]byte("")), nil)
+	assertEquals(t, set.Size(), 0)
+}
+
+func TestTextSet_UnmarshalText_DanglingEscape(t *testing.T) {
+	set := NewSet[string]()
+	set.Add("kept")
+	text := TextSet[string]{set}
+
+	err := text.UnmarshalText([]byte(`a,b\`))
+	assertEquals(t, errors.Is(err, ErrDanglingEscape), true)
+	assertEquals(t, set.Size(), 1)
+	assertEquals(t, set.Contains("kept"), true)
+
+	// An escaped backslash at the end is not dangling
+	assertEquals(t, text.UnmarshalText([]byte(`a,b\\`)), nil)
+	assertEquals(t, set.Contains(`b\`), true)
+}
+
+func TestTextSet_UnmarshalText_InsertionOrder(t *testing.T) {
+	set := NewSet[string](WithInsertionOrder())
+	set.Add("z")
+	set.Add("y")
+
+	text := TextSet[string]{set}
+	assertEquals(t, text.UnmarshalText([]byte("c,b,a,b")), nil)
+	assertEquals(t, slices.Equal(set.Members(), []string{"c", "b", "a"}), true)
+
+	set.Add("q")
+	assertEquals(t, slices.Equal(set.Members(), []string{"c", "b", "a", "q"}), true)
+	assertEquals(t, len(set.order), 4)
+}
+
+func TestTextSet_RoundTrip(t *testing.T) {
+	type Colour string
+
+	original := NewSet[Colour]()
+	original.Add("red")
+	original.Add("green, blue")
+	original.Add(`back\slash`)
+
+	text, err := TextSet[Colour]{original}.MarshalText()
+	assertEquals(t, err, nil)
+	decoded := TextSet[Colour]{NewSet[Colour]()}
+	assertEquals(t, decoded.UnmarshalText(text), nil)
+	assertSameMembers(t, decoded.Set, original)
+}
+
+func TestTextSet_JSON(t *testing.T) {
+	type config struct {
+		Tags TextSet[string] `json:"tags"`
+	}
+
+	// encoding/json decodes string values through encoding.TextUnmarshaler
+	var decoded config
+	err := json.Unmarshal([]byte(`{"tags":"b, a,c\\,d"}`), &decoded)
+	assertEquals(t, err, nil)
+	assertEquals(t, decoded.Tags.Size(), 3)
+	assertEquals(t, decoded.Tags.Contains("a"), true)
+	assertEquals(t, decoded.Tags.Contains("b"), true)
+	assertEquals(t, decoded.Tags.Contains("c,d"), true)
+
+	encoded, err := json.Marshal(decoded)
+	assertEquals(t, err, nil)
+	assertEquals(t, string(encoded), `{"tags":"a,b,c\\,d"}`)
+
+	err = json.Unmarshal([]byte(`{"tags":"a\\"}`), &decoded)
+	assertEquals(t, errors.Is(err, ErrDanglingEscape), true)
+}
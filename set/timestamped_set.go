@@ -0,0 +1,137 @@
+package set
+
+import (
+	"sync"
+	"time"
+)
+
+// TimestampedSet represents a thread-safe collection of unique elements that records when each
+// element was last added, making it suitable for "recently seen" tracking.
+// The zero value is not usable; use NewTimestampedSet to create a new TimestampedSet.
+type TimestampedSet[T comparable] struct {
+	members map[T]time.Time
+	now     func() time.Time
+	mu      sync.RWMutex
+}
+
+// NewTimestampedSet creates and initializes a new empty TimestampedSet using time.Now as its clock.
+//
+// Example:
+//
+//	s := NewTimestampedSet[string]()
+//	s.Add("foo")
+func NewTimestampedSet[T comparable]() *TimestampedSet[T] {
+	return &TimestampedSet[T]{
+		members: make(map[T]time.Time),
+		now:     time.Now,
+	}
+}
+
+// SetClock replaces the function used to timestamp added elements, which is useful in tests.
+//
+// Example:
+//
+//	s := NewTimestampedSet[string]()
+//	s.SetClock(func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) })
+func (s *TimestampedSet[T]) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// Add inserts an element into the TimestampedSet, recording the current time.
+// If the element already exists, its timestamp is refreshed.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewTimestampedSet[int]()
+//	s.Add(1) // Set now contains 1, added now
+func (s *TimestampedSet[T]) Add(member T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.members[member] = s.now()
+}
+
+// Remove deletes an element and its timestamp from the TimestampedSet.
+// If the element doesn't exist, the TimestampedSet remains unchanged.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewTimestampedSet[int]()
+//	s.Add(1)
+//	s.Remove(1) // Set is now empty
+func (s *TimestampedSet[T]) Remove(member T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.members, member)
+}
+
+// Contains returns true if the element exists in the TimestampedSet, false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewTimestampedSet[string]()
+//	s.Add("foo")
+//	fmt.Println(s.Contains("foo")) // Output: true
+func (s *TimestampedSet[T]) Contains(member T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, exists := s.members[member]
+	return exists
+}
+
+// Size returns the number of elements in the TimestampedSet.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewTimestampedSet[int]()
+//	s.Add(1)
+//	fmt.Println(s.Size()) // Output: 1
+func (s *TimestampedSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.members)
+}
+
+// AddedAt returns the time the element was last added.
+// Returns the time and true if the element exists, or zero time and false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewTimestampedSet[string]()
+//	s.Add("foo")
+//	addedAt, ok := s.AddedAt("foo") // addedAt = time of Add, ok = true
+func (s *TimestampedSet[T]) AddedAt(member T) (time.Time, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	addedAt, exists := s.members[member]
+	return addedAt, exists
+}
+
+// OlderThan returns the elements that were last added more than d ago, according to the set's clock.
+// The order of elements is not guaranteed to be stable between calls.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewTimestampedSet[string]()
+//	s.Add("foo")
+//	time.Sleep(2 * time.Second)
+//	fmt.Println(s.OlderThan(time.Second)) // Output: [foo]
+func (s *TimestampedSet[T]) OlderThan(d time.Duration) []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cutoff := s.now().Add(-d)
+	members := make([]T, 0)
+	for member, addedAt := range s.members {
+		if addedAt.Before(cutoff) {
+			members = append(members, member)
+		}
+	}
+	return members
+}
@@ -0,0 +1,53 @@
+package set
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTimestampedSet(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	start := now
+
+	set := NewTimestampedSet[string]()
+	set.SetClock(func() time.Time { return now })
+
+	_, ok := set.AddedAt("foo")
+	assertEquals(t, ok, false)
+
+	set.Add("foo")
+	now = now.Add(time.Minute)
+	set.Add("bar")
+	now = now.Add(time.Minute)
+	set.Add("baz")
+
+	assertEquals(t, set.Size(), 3)
+	assertEquals(t, set.Contains("foo"), true)
+
+	addedAt, ok := set.AddedAt("foo")
+	assertEquals(t, ok, true)
+	assertEquals(t, addedAt, start)
+
+	addedAt, ok = set.AddedAt("bar")
+	assertEquals(t, ok, true)
+	assertEquals(t, addedAt, start.Add(time.Minute))
+
+	now = now.Add(30 * time.Second)
+	older := set.OlderThan(time.Minute)
+	assertEquals(t, len(older), 2)
+	assertEquals(t, slices.Contains(older, "foo"), true)
+	assertEquals(t, slices.Contains(older, "bar"), true)
+
+	// Re-adding refreshes the timestamp
+	set.Add("foo")
+	older = set.OlderThan(time.Minute)
+	assertEquals(t, len(older), 1)
+	assertEquals(t, slices.Contains(older, "bar"), true)
+
+	set.Remove("bar")
+	assertEquals(t, len(set.OlderThan(time.Minute)), 0)
+	_, ok = set.AddedAt("bar")
+	assertEquals(t, ok, false)
+	assertEquals(t, set.Size(), 2)
+}
@@ -0,0 +1,44 @@
+package set
+
+// Pair is a comparable two-field value for use as a composite Set member.
+//
+// Example:
+//
+//	s := NewSet[Pair[string, int]]()
+//	s.Add(NewPair("alice", 1))
+type Pair[A, B comparable] struct {
+	First  A
+	Second B
+}
+
+// NewPair creates a Pair from its two fields.
+//
+// Example:
+//
+//	p := NewPair("alice", 1)
+//	fmt.Println(p.First, p.Second) // Output: alice 1
+func NewPair[A, B comparable](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Triple is a comparable three-field value for use as a composite Set member.
+//
+// Example:
+//
+//	s := NewSet[Triple[string, int, bool]]()
+//	s.Add(NewTriple("alice", 1, true))
+type Triple[A, B, C comparable] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple creates a Triple from its three fields.
+//
+// Example:
+//
+//	t := NewTriple("alice", 1, true)
+//	fmt.Println(t.First, t.Second, t.Third) // Output: alice 1 true
+func NewTriple[A, B, C comparable](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
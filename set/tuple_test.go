@@ -0,0 +1,31 @@
+package set
+
+import "testing"
+
+func TestPair(t *testing.T) {
+	set := NewSet[Pair[string, int]]()
+	set.Add(NewPair("alice", 1))
+	set.Add(NewPair("alice", 2))
+	set.Add(NewPair("bob", 1))
+	set.Add(NewPair("alice", 1))
+
+	assertEquals(t, set.Size(), 3)
+	assertEquals(t, set.Contains(Pair[string, int]{First: "alice", Second: 2}), true)
+	assertEquals(t, set.Contains(NewPair("bob", 2)), false)
+}
+
+func TestTriple(t *testing.T) {
+	set := NewSet[Triple[string, int, bool]]()
+	set.Add(NewTriple("alice", 1, true))
+	set.Add(NewTriple("alice", 1, false))
+	set.Add(NewTriple("alice", 1, true))
+
+	assertEquals(t, set.Size(), 2)
+	assertEquals(t, set.Contains(NewTriple("alice", 1, false)), true)
+	assertEquals(t, set.Contains(NewTriple("alice", 2, false)), false)
+
+	triple := NewTriple("bob", 2, true)
+	assertEquals(t, triple.First, "bob")
+	assertEquals(t, triple.Second, 2)
+	assertEquals(t, triple.Third, true)
+}
@@ -0,0 +1,159 @@
+package stack
+
+import (
+	"slices"
+	"sync"
+)
+
+// Stack represents a thread-safe generic LIFO stack data structure.
+// Elements are pushed onto and popped from the top.
+// All methods may be called concurrently from multiple goroutines.
+// The zero value is not usable; use NewStack to create a new Stack.
+type Stack[T any] struct {
+	elements []T
+	mu       sync.RWMutex
+}
+
+// NewStack creates and returns an empty stack that can store elements of type T.
+//
+// Example:
+//
+//	s := NewStack[int]()
+//	s.Push(1)
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{
+		elements: make([]T, 0),
+	}
+}
+
+// Push adds an element to the top of the stack.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStack[int]()
+//	s.Push(1) // stack now contains: [1]
+//	s.Push(2) // stack now contains: [1, 2]
+func (s *Stack[T]) Push(element T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elements = append(s.elements, element)
+}
+
+// Pop removes and returns the element at the top of the stack.
+// Returns the element and true if successful, or zero value and false if the stack is empty.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	val, ok := s.Pop() // val = 2, ok = true
+//	val, ok = s.Pop()  // val = 1, ok = true
+//	val, ok = s.Pop()  // val = 0, ok = false (stack empty)
+func (s *Stack[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.elements) == 0 {
+		var empty T
+		return empty, false
+	}
+
+	top := len(s.elements) - 1
+	element := s.elements[top]
+
+	// release the popped slot, as it stays in the backing array
+	var empty T
+	s.elements[top] = empty
+	s.elements = s.elements[:top]
+
+	return element, true
+}
+
+// Peek returns the element at the top of the stack without removing it.
+// Returns the element and true if successful, or zero value and false if the stack is empty.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStack[int]()
+//	s.Push(1)
+//	val, ok := s.Peek() // val = 1, ok = true, stack still contains: [1]
+func (s *Stack[T]) Peek() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.elements) == 0 {
+		var empty T
+		return empty, false
+	}
+
+	return s.elements[len(s.elements)-1], true
+}
+
+// Length returns the number of elements currently in the stack.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	fmt.Println(s.Length()) // Output: 2
+func (s *Stack[T]) Length() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.elements)
+}
+
+// IsEmpty returns true if the stack contains no elements, false otherwise.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStack[int]()
+//	fmt.Println(s.IsEmpty()) // Output: true
+//	s.Push(1)
+//	fmt.Println(s.IsEmpty()) // Output: false
+func (s *Stack[T]) IsEmpty() bool {
+	return s.Length() == 0
+}
+
+// Drain removes every element from the stack and returns them in pop order, top first.
+// The stack is emptied under a single lock, so no concurrent Push can interleave.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	fmt.Println(s.Drain())   // Output: [2 1]
+//	fmt.Println(s.IsEmpty()) // Output: true
+func (s *Stack[T]) Drain() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elements := s.elements
+	s.elements = nil
+	slices.Reverse(elements)
+
+	return elements
+}
+
+// ToSlice returns a copy of the stack's elements from bottom to top, i.e. in push order,
+// without modifying the stack. The last element of the slice is the one Peek would return.
+// This operation is thread-safe.
+//
+// Example:
+//
+//	s := NewStack[int]()
+//	s.Push(1)
+//	s.Push(2)
+//	fmt.Println(s.ToSlice()) // Output: [1 2]
+//	fmt.Println(s.Length())  // Output: 2
+func (s *Stack[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return slices.Clone(s.elements)
+}
@@ -0,0 +1,129 @@
+package stack
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	var v int
+	var ok bool
+
+	stack := NewStack[int]()
+	assertEquals(t, stack.Length(), 0)
+	assertEquals(t, stack.IsEmpty(), true)
+
+	v, ok = stack.Peek()
+	assertEquals(t, ok, false)
+
+	stack.Push(10)
+	stack.Push(20)
+	assertEquals(t, stack.Length(), 2)
+	assertEquals(t, stack.IsEmpty(), false)
+
+	v, ok = stack.Peek()
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 20)
+	assertEquals(t, stack.Length(), 2)
+
+	v, ok = stack.Pop()
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 20)
+	assertEquals(t, stack.Length(), 1)
+
+	v, ok = stack.Pop()
+	assertEquals(t, ok, true)
+	assertEquals(t, v, 10)
+	assertEquals(t, stack.IsEmpty(), true)
+
+	v, ok = stack.Pop()
+	assertEquals(t, ok, false)
+	assertEquals(t, v, 0)
+
+	stack.Push(30)
+	assertEquals(t, stack.Length(), 1)
+}
+
+func TestStack_DrainToSlice(t *testing.T) {
+	stack := NewStack[int]()
+	assertEquals(t, len(stack.Drain()), 0)
+	assertEquals(t, len(stack.ToSlice()), 0)
+
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	snapshot := stack.ToSlice()
+	assertEquals(t, slices.Equal(snapshot, []int{1, 2, 3}), true)
+	assertEquals(t, stack.Length(), 3)
+
+	// The snapshot is independent of the stack
+	snapshot[2] = 30
+	v, _ := stack.Peek()
+	assertEquals(t, v, 3)
+
+	assertEquals(t, slices.Equal(stack.Drain(), []int{3, 2, 1}), true)
+	assertEquals(t, stack.IsEmpty(), true)
+
+	stack.Push(4)
+	assertEquals(t, slices.Equal(stack.ToSlice(), []int{4}), true)
+}
+
+func TestStack_Concurrent(t *testing.T) {
+	stack := NewStack[int]()
+	const pushers = 4
+	const poppers = 4
+	const perPusher = 500
+	const total = pushers * perPusher
+
+	var wg sync.WaitGroup
+	var popped atomic.Int64
+	seen := make([]atomic.Int32, total)
+
+	// Pushers and poppers run at the same time, so Push and Pop overlap
+	for g := 0; g < pushers; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perPusher; i++ {
+				stack.Push(g*perPusher + i)
+				stack.Peek()
+				stack.Length()
+			}
+		}()
+	}
+	for g := 0; g < poppers; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for popped.Load() < total {
+				v, ok := stack.Pop()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				seen[v].Add(1)
+				popped.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assertEquals(t, popped.Load(), int64(total))
+	for v := range seen {
+		if seen[v].Load() != 1 {
+			t.Errorf("value %d popped %d times, want 1", v, seen[v].Load())
+		}
+	}
+	assertEquals(t, stack.IsEmpty(), true)
+}
+
+func assertEquals[V comparable](t *testing.T, got, want V) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,135 @@
+package unionfind
+
+// UnionFind represents a disjoint-set forest for tracking which elements are connected.
+// It uses path compression and union by rank, so operations run in near-constant amortised time.
+// Elements that have not been added are added automatically as singleton sets the first time
+// they are passed to Union, Find or Connected.
+// The zero value is not usable; use NewUnionFind to create a new UnionFind.
+type UnionFind[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	count  int
+}
+
+// NewUnionFind creates and returns an empty UnionFind.
+//
+// Example:
+//
+//	uf := NewUnionFind[string]()
+//	uf.Union("a", "b")
+func NewUnionFind[T comparable]() *UnionFind[T] {
+	return &UnionFind[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+	}
+}
+
+// MakeSet adds element as a new singleton set.
+// If the element already exists, the UnionFind remains unchanged.
+//
+// Example:
+//
+//	uf := NewUnionFind[int]()
+//	uf.MakeSet(1)
+//	fmt.Println(uf.Count()) // Output: 1
+func (u *UnionFind[T]) MakeSet(element T) {
+	if _, exists := u.parent[element]; exists {
+		return
+	}
+
+	u.parent[element] = element
+	u.rank[element] = 0
+	u.count++
+}
+
+// Find returns the representative element of the set containing element.
+// Two elements are connected exactly when they have the same representative.
+//
+// Example:
+//
+//	uf := NewUnionFind[int]()
+//	uf.Union(1, 2)
+//	fmt.Println(uf.Find(1) == uf.Find(2)) // Output: true
+func (u *UnionFind[T]) Find(element T) T {
+	u.MakeSet(element)
+
+	root := element
+	for u.parent[root] != root {
+		root = u.parent[root]
+	}
+
+	// Path compression: point every element on the path directly at the root
+	for element != root {
+		next := u.parent[element]
+		u.parent[element] = root
+		element = next
+	}
+
+	return root
+}
+
+// Union merges the sets containing a and b.
+// If they are already in the same set, the UnionFind remains unchanged.
+//
+// Example:
+//
+//	uf := NewUnionFind[int]()
+//	uf.Union(1, 2)
+//	uf.Union(2, 3)
+//	fmt.Println(uf.Connected(1, 3)) // Output: true
+func (u *UnionFind[T]) Union(a, b T) {
+	rootA := u.Find(a)
+	rootB := u.Find(b)
+	if rootA == rootB {
+		return
+	}
+
+	// Union by rank: attach the shallower tree beneath the deeper one
+	switch {
+	case u.rank[rootA] < u.rank[rootB]:
+		u.parent[rootA] = rootB
+	case u.rank[rootA] > u.rank[rootB]:
+		u.parent[rootB] = rootA
+	default:
+		u.parent[rootB] = rootA
+		u.rank[rootA]++
+	}
+	u.count--
+}
+
+// Connected returns true if a and b are in the same set, false otherwise.
+//
+// Example:
+//
+//	uf := NewUnionFind[int]()
+//	uf.Union(1, 2)
+//	fmt.Println(uf.Connected(1, 2)) // Output: true
+//	fmt.Println(uf.Connected(1, 3)) // Output: false
+func (u *UnionFind[T]) Connected(a, b T) bool {
+	return u.Find(a) == u.Find(b)
+}
+
+// Count returns the number of disjoint sets (connected components).
+//
+// Example:
+//
+//	uf := NewUnionFind[int]()
+//	uf.MakeSet(1)
+//	uf.MakeSet(2)
+//	uf.MakeSet(3)
+//	uf.Union(1, 2)
+//	fmt.Println(uf.Count()) // Output: 2
+func (u *UnionFind[T]) Count() int {
+	return u.count
+}
+
+// Len returns the number of elements across all sets.
+//
+// Example:
+//
+//	uf := NewUnionFind[int]()
+//	uf.Union(1, 2)
+//	fmt.Println(uf.Len()) // Output: 2
+func (u *UnionFind[T]) Len() int {
+	return len(u.parent)
+}
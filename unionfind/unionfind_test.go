@@ -0,0 +1,65 @@
+package unionfind
+
+import (
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	uf := NewUnionFind[string]()
+	assertEquals(t, uf.Count(), 0)
+
+	for _, element := range []string{"a", "b", "c", "d", "e", "f"} {
+		uf.MakeSet(element)
+	}
+	uf.MakeSet("a")
+	assertEquals(t, uf.Count(), 6)
+	assertEquals(t, uf.Len(), 6)
+	assertEquals(t, uf.Connected("a", "b"), false)
+	assertEquals(t, uf.Find("a"), "a")
+
+	// Components: {a, b, c}, {d, e}, {f}
+	uf.Union("a", "b")
+	uf.Union("b", "c")
+	uf.Union("d", "e")
+	assertEquals(t, uf.Count(), 3)
+	assertEquals(t, uf.Connected("a", "c"), true)
+	assertEquals(t, uf.Connected("c", "a"), true)
+	assertEquals(t, uf.Connected("d", "e"), true)
+	assertEquals(t, uf.Connected("a", "d"), false)
+	assertEquals(t, uf.Connected("f", "a"), false)
+	assertEquals(t, uf.Find("a"), uf.Find("c"))
+
+	// Unioning members already connected has no effect
+	uf.Union("c", "a")
+	assertEquals(t, uf.Count(), 3)
+
+	// Merging two components connects every member of both
+	uf.Union("c", "e")
+	assertEquals(t, uf.Count(), 2)
+	assertEquals(t, uf.Connected("a", "d"), true)
+	assertEquals(t, uf.Connected("b", "e"), true)
+	assertEquals(t, uf.Connected("f", "e"), false)
+
+	// Unknown elements are added as singletons
+	assertEquals(t, uf.Connected("g", "f"), false)
+	assertEquals(t, uf.Count(), 3)
+	assertEquals(t, uf.Len(), 7)
+}
+
+func TestUnionFind_LongChain(t *testing.T) {
+	uf := NewUnionFind[int]()
+	for i := 1; i < 1000; i++ {
+		uf.Union(i-1, i)
+	}
+
+	assertEquals(t, uf.Count(), 1)
+	assertEquals(t, uf.Len(), 1000)
+	assertEquals(t, uf.Connected(0, 999), true)
+}
+
+func assertEquals[V comparable](t *testing.T, got, want V) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}